@@ -22,19 +22,22 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
-	"github.com/operator-framework/operator-sdk/pkg/leader"
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
 	"github.com/operator-framework/operator-sdk/pkg/metrics"
 	sdkVersion "github.com/operator-framework/operator-sdk/version"
 	"github.com/spf13/pflag"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -42,7 +45,9 @@ import (
 
 	"github.com/litmuschaos/chaos-operator/pkg/analytics"
 	"github.com/litmuschaos/chaos-operator/pkg/apis"
+	operatorConfig "github.com/litmuschaos/chaos-operator/pkg/config"
 	"github.com/litmuschaos/chaos-operator/pkg/controller"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
 )
 
 // Change below variables to serve metrics on different host or port.
@@ -52,6 +57,18 @@ var (
 	log               = logf.Log.WithName("cmd")
 )
 
+// Leader election configuration, which can be overridden by the flags
+// controller-runtime v0.4.0 only supports the configmap as leader election lock. The lock doesn't reuse the
+// chaos-operator-lock configmap of the previous leader-for-life election, which is owned by the previous leader pod
+var (
+	leaderElection          = true
+	leaderElectionID        = "chaos-operator-leader-election"
+	leaderElectionNamespace = ""
+	leaseDuration           = 15 * time.Second
+	renewDeadline           = 10 * time.Second
+	retryPeriod             = 2 * time.Second
+)
+
 func main() {
 	// initializing the log configuration
 	initializingLogConfiguration()
@@ -84,6 +101,9 @@ func initializingLogConfiguration() {
 	// controller-runtime)
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 
+	// Add the flags of chaos-operator
+	addOperatorFlags()
+
 	pflag.Parse()
 
 	// Use a zap logr.Logger implementation. If none of the zap
@@ -97,6 +117,52 @@ func initializingLogConfiguration() {
 	logf.SetLogger(zap.Logger())
 }
 
+// addOperatorFlags adds the flags, which configure the chaos-operator
+// The defaults of the flags can also be provided by the respective ENVs
+func addOperatorFlags() {
+	pflag.DurationVar(&chaosTypes.TerminationTimeout, "termination-timeout", getDurationEnv("TERMINATION_TIMEOUT", chaosTypes.TerminationTimeout),
+		"Duration for which an aborted chaosengine waits for the termination of its chaos pods")
+	pflag.IntVar(&chaosTypes.MaxConcurrentReconciles, "max-concurrent-reconciles", getIntEnv("MAX_CONCURRENT_RECONCILES", chaosTypes.MaxConcurrentReconciles),
+		"Maximum number of chaosengines, which can be reconciled concurrently")
+	pflag.DurationVar(&chaosTypes.RateLimiterBaseDelay, "rate-limiter-base-delay", getDurationEnv("RATE_LIMITER_BASE_DELAY", chaosTypes.RateLimiterBaseDelay),
+		"Delay for the first requeue of a failed reconcile, which is doubled on each consecutive failure")
+	pflag.DurationVar(&chaosTypes.RateLimiterMaxDelay, "rate-limiter-max-delay", getDurationEnv("RATE_LIMITER_MAX_DELAY", chaosTypes.RateLimiterMaxDelay),
+		"Maximum delay for the requeue of a failed reconcile")
+	pflag.DurationVar(&chaosTypes.ResyncPeriod, "resync-period", getDurationEnv("RESYNC_PERIOD", chaosTypes.ResyncPeriod),
+		"Minimum interval at which all the watched resources are reconciled")
+
+	pflag.BoolVar(&leaderElection, "leader-elect", leaderElection,
+		"Enable the configmap based leader election, to run a single active chaos-operator among the replicas")
+	pflag.StringVar(&leaderElectionID, "leader-election-id", leaderElectionID,
+		"Name of the configmap, which is used as leader election lock (controller-runtime v0.4.0 only supports the configmap lock)")
+	pflag.StringVar(&leaderElectionNamespace, "leader-election-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace of the leader election configmap, defaults to the namespace of chaos-operator")
+	pflag.DurationVar(&leaseDuration, "leader-election-lease-duration", leaseDuration,
+		"Duration for which the non-leader candidates wait, before trying to acquire the leadership")
+	pflag.DurationVar(&renewDeadline, "leader-election-renew-deadline", renewDeadline,
+		"Duration for which the leader retries to refresh the leadership, before giving it up")
+	pflag.DurationVar(&retryPeriod, "leader-election-retry-period", retryPeriod,
+		"Interval between the attempts of the candidates to acquire or renew the leadership")
+}
+
+// getIntEnv returns the value of the ENV as int, or the default value if it is not set or invalid
+func getIntEnv(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// getDurationEnv returns the value of the ENV as duration, or the default value if it is not set or invalid
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
 func printVersion() {
 	log.Info(fmt.Sprintf("Go Version: %s", runtime.Version()))
 	log.Info(fmt.Sprintf("Go OS/Arch: %s/%s", runtime.GOOS, runtime.GOARCH))
@@ -105,11 +171,6 @@ func printVersion() {
 
 // initializing the configuration of chaos-operator
 func initialConfiguration() (manager.Manager, error) {
-	//setting up leader and analytics
-	if err := initializingLeaderAndAnalytics(); err != nil {
-		return nil, err
-	}
-
 	// creating metrics service
 	cfg, namespace, err := initializeMetricsService()
 	if err != nil {
@@ -121,17 +182,17 @@ func initialConfiguration() (manager.Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	return mgr, nil
-}
 
-func initializingLeaderAndAnalytics() error {
-	// Become the leader before proceeding
-	if err := leader.Become(context.TODO(), "chaos-operator-lock"); err != nil {
-		return err
+	//setting up analytics after loading the operator configuration, leader election is done by the manager
+	if err := initializingAnalytics(); err != nil {
+		return nil, err
 	}
+	return mgr, nil
+}
 
+func initializingAnalytics() error {
 	// Trigger the Analytics if it's enabled
-	if isAnalytics := strings.ToUpper(os.Getenv("ANALYTICS")); isAnalytics != "FALSE" {
+	if operatorConfig.Get().Analytics {
 		if err := analytics.TriggerAnalytics(); err != nil {
 			log.Error(err, "")
 		}
@@ -162,8 +223,33 @@ func initializeMetricsService() (*rest.Config, string, error) {
 
 func registerComponents(cfg *rest.Config, namespace string) (manager.Manager, error) {
 
+	options := manager.Options{
+		Namespace:               namespace,
+		MetricsBindAddress:      fmt.Sprintf("%s:%d", metricsHost, metricsPort),
+		SyncPeriod:              &chaosTypes.ResyncPeriod,
+		LeaderElection:          isLeaderElectionEnabled(),
+		LeaderElectionID:        leaderElectionID,
+		LeaderElectionNamespace: leaderElectionNamespace,
+		LeaseDuration:           &leaseDuration,
+		RenewDeadline:           &renewDeadline,
+		RetryPeriod:             &retryPeriod,
+	}
+
+	// Watch a set of namespaces with a multi-namespaced cache, if a comma separated list is provided
+	// Otherwise the single namespace is used without the empty entries and surrounding spaces
+	namespaces := getWatchNamespaces(namespace)
+	chaosTypes.WatchNamespaces = namespaces
+	switch {
+	case len(namespaces) > 1:
+		log.Info("Watching multiple namespaces", "namespaces", namespaces)
+		options.Namespace = ""
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	case len(namespaces) == 1:
+		options.Namespace = namespaces[0]
+	}
+
 	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(cfg, manager.Options{Namespace: namespace, MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort)})
+	mgr, err := manager.New(cfg, options)
 	if err != nil {
 		return mgr, err
 	}
@@ -173,6 +259,11 @@ func registerComponents(cfg *rest.Config, namespace string) (manager.Manager, er
 		return nil, err
 	}
 
+	// Load the operator configuration and watch it for the updates
+	if err := addConfigWatcher(mgr); err != nil {
+		return nil, err
+	}
+
 	// Setup all Controllers
 	if err := controller.AddToManager(mgr); err != nil {
 		return nil, err
@@ -180,3 +271,48 @@ func registerComponents(cfg *rest.Config, namespace string) (manager.Manager, er
 
 	return mgr, nil
 }
+
+// isLeaderElectionEnabled checks whether the leader election is enabled, and the namespace of its lock can be resolved
+// The leader election is skipped for the local and out-of-cluster runs, where the namespace of chaos-operator is unknown
+func isLeaderElectionEnabled() bool {
+	if !leaderElection || leaderElectionNamespace != "" {
+		return leaderElection
+	}
+	if _, err := k8sutil.GetOperatorNamespace(); err != nil {
+		log.Info("Skipping the leader election, as the namespace of chaos-operator can't be resolved", "reason", err.Error())
+		return false
+	}
+	return true
+}
+
+// addConfigWatcher loads the operator configmap from the namespace of chaos-operator,
+// and adds its watcher to the manager, which applies the updates without restarting the operator
+func addConfigWatcher(mgr manager.Manager) error {
+	operatorNamespace := os.Getenv("POD_NAMESPACE")
+	if operatorNamespace == "" {
+		log.Info("POD_NAMESPACE is not set, using the default operator configuration")
+		return nil
+	}
+
+	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	configWatcher := operatorConfig.NewWatcher(clientSet, operatorNamespace)
+	if err := configWatcher.Load(); err != nil {
+		return err
+	}
+	return mgr.Add(configWatcher)
+}
+
+// getWatchNamespaces splits the comma separated list of watch namespaces
+func getWatchNamespaces(namespace string) []string {
+	var namespaces []string
+	for _, ns := range strings.Split(namespace, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
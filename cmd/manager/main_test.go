@@ -0,0 +1,58 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetWatchNamespaces(t *testing.T) {
+	tests := map[string]struct {
+		namespace  string
+		namespaces []string
+	}{
+		"Test Positive-1": {
+			namespace:  "",
+			namespaces: nil,
+		},
+		"Test Positive-2": {
+			namespace:  "ns1",
+			namespaces: []string{"ns1"},
+		},
+		"Test Positive-3": {
+			namespace:  " ns1",
+			namespaces: []string{"ns1"},
+		},
+		"Test Positive-4": {
+			namespace:  "ns1,",
+			namespaces: []string{"ns1"},
+		},
+		"Test Positive-5": {
+			namespace:  "ns1, ns2,,ns3 ",
+			namespaces: []string{"ns1", "ns2", "ns3"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			namespaces := getWatchNamespaces(mock.namespace)
+			if !reflect.DeepEqual(namespaces, mock.namespaces) {
+				t.Fatalf("Test %q failed: expected namespaces %v, got: %v", name, mock.namespaces, namespaces)
+			}
+		})
+	}
+}
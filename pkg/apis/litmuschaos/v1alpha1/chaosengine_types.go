@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ChaosEngineSpec defines the desired state of ChaosEngine
@@ -38,12 +39,18 @@ type ChaosEngineSpec struct {
 	Experiments []ExperimentList `json:"experiments"`
 	//JobCleanUpPolicy decides to retain or delete the jobs
 	JobCleanUpPolicy CleanUpPolicy `json:"jobCleanUpPolicy,omitempty"`
+	//TTLSecondsAfterFinished is the duration after the completion, after which the retained chaos resources are deleted
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	//ChaosResultCleanUpPolicy decides to retain or delete the chaosresults, on the deletion of the engine
+	ChaosResultCleanUpPolicy CleanUpPolicy `json:"chaosResultCleanUpPolicy,omitempty"`
 	//AuxiliaryAppInfo contains details of dependent applications (infra chaos)
 	AuxiliaryAppInfo string `json:"auxiliaryAppInfo,omitempty"`
 	//EngineStatus is a requirement for validation
 	EngineState EngineState `json:"engineState"`
 	// TerminationGracePeriodSeconds contains terminationGracePeriod for the chaos resources
 	TerminationGracePeriodSeconds int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	//LogArchive archives the logs of the runner and experiment pods, before they are deleted
+	LogArchive *LogArchive `json:"logArchive,omitempty"`
 }
 
 // EngineState provides interface for all supported strings in spec.EngineState
@@ -64,6 +71,8 @@ const (
 	ExperimentStatusRunning ExperimentStatus = "Running"
 	// ExperimentStatusCompleted is status of Experiment which has been completed
 	ExperimentStatusCompleted ExperimentStatus = "Completed"
+	// ExperimentStatusFailed is status of Experiment whose job has failed
+	ExperimentStatusFailed ExperimentStatus = "Failed"
 	// ExperimentStatusWaiting is status of Experiment which will be executed via a Job
 	ExperimentStatusWaiting ExperimentStatus = "Waiting for Job Creation"
 	// ExperimentStatusNotFound is status of Experiment which is not found inside ChaosNamespace
@@ -82,6 +91,8 @@ const (
 	EngineStatusInitialized EngineStatus = "initialized"
 	// EngineStatusCompleted is used for reconcile calls to start reconcile for completion
 	EngineStatusCompleted EngineStatus = "completed"
+	// EngineStatusStopping is used for reconcile calls to wait for the termination of chaos pods after abort
+	EngineStatusStopping EngineStatus = "stopping"
 	// EngineStatusStopped is used for reconcile calls to start reconcile for delete
 	EngineStatusStopped EngineStatus = "stopped"
 )
@@ -95,8 +106,34 @@ const (
 
 	//CleanUpPolicyRetain sets the garbage collection policy of chaos-operator to Retain Chaos Resources
 	CleanUpPolicyRetain CleanUpPolicy = "retain"
+
+	//CleanUpPolicyRetainOnFailure sets the garbage collection policy of chaos-operator to Retain Chaos Resources, only if any experiment has failed
+	CleanUpPolicyRetainOnFailure CleanUpPolicy = "retainOnFailure"
+)
+
+// LogArchiveType defines the destination of the archived logs
+type LogArchiveType string
+
+const (
+	//LogArchiveTypeConfigMap archives the logs in a configmap, owned by the chaosengine
+	LogArchiveTypeConfigMap LogArchiveType = "configmap"
+
+	//LogArchiveTypePVC archives the logs in a directory of the pvc, named after the chaosengine and its run id
+	LogArchiveTypePVC LogArchiveType = "pvc"
 )
 
+// LogArchive defines the archival of the logs of the chaos pods, which are deleted on completion
+type LogArchive struct {
+	//Type is the destination of the archived logs, it can be configmap or pvc
+	Type LogArchiveType `json:"type"`
+	//TailLines is the number of lines archived from the end of the logs of each container
+	TailLines *int64 `json:"tailLines,omitempty"`
+	//ClaimName is the name of the pvc, which stores the logs for the pvc type
+	ClaimName string `json:"claimName,omitempty"`
+	//Image is the image of the pod, which copies the logs into the pvc
+	Image string `json:"image,omitempty"`
+}
+
 // ChaosEngineStatus defines the observed state of ChaosEngine
 // +k8s:openapi-gen=true
 
@@ -106,6 +143,38 @@ type ChaosEngineStatus struct {
 	EngineStatus EngineStatus `json:"engineStatus"`
 	//Detailed status of individual experiments
 	Experiments []ExperimentStatuses `json:"experiments"`
+	//Verdict is the overall verdict of the engine, derived from the verdicts of its ChaosResults
+	Verdict ResultVerdict `json:"verdict,omitempty"`
+	//StopInitiatedTime is the time at which the abort of the engine has been initiated
+	StopInitiatedTime *metav1.Time `json:"stopInitiatedTime,omitempty"`
+	//RunID is the unique id of the current execution of the engine, which is regenerated on each restart
+	RunID string `json:"runID,omitempty"`
+	//CompletionTime is the time at which the engine has been completed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	//Conditions contains the latest observations of the state of the engine
+	Conditions []ChaosEngineCondition `json:"conditions,omitempty"`
+}
+
+// ChaosEngineConditionType defines the type of the conditions of ChaosEngine
+type ChaosEngineConditionType string
+
+const (
+	// ChaosEngineConditionRevertIncomplete is the condition of an aborted engine, whose chaos is still injected on some targets
+	ChaosEngineConditionRevertIncomplete ChaosEngineConditionType = "RevertIncomplete"
+)
+
+// ChaosEngineCondition describes the state of the ChaosEngine at a certain point
+type ChaosEngineCondition struct {
+	//Type of the condition
+	Type ChaosEngineConditionType `json:"type"`
+	//Status of the condition, it can be True, False or Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	//LastTransitionTime is the time at which the condition has been changed
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	//Reason is the brief reason of the condition
+	Reason string `json:"reason,omitempty"`
+	//Message is the human readable details of the condition
+	Message string `json:"message,omitempty"`
 }
 
 // ApplicationParams defines information about Application-Under-Test (AUT) on the cluster
@@ -129,8 +198,8 @@ type ComponentParams struct {
 type RunnerInfo struct {
 	//Image of the runner pod
 	Image string `json:"image,omitempty"`
-	//Type of runner
-	Type string `json:"type,omitempty"`
+	//Type of runner, which selects the backend launching the runner (go, job, simulated or runnerless)
+	Type RunnerType `json:"type,omitempty"`
 	//Args of runner
 	Args []string `json:"args,omitempty"`
 	//Command for runner
@@ -151,8 +220,36 @@ type RunnerInfo struct {
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
 	// Resource requirements for the runner pod
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// BackoffLimit is the number of retries of the runner job, before marking it failed
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is the duration for which the runner job may be active, before it is terminated
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// VolumeSources contains the emptyDir, pvc, hostPath and projected token volumes of the runner pod
+	VolumeSources `json:",inline"`
+	// ENV contains the additional ENVs of the runner, the ENVs generated by the operator can't be overridden
+	ENV []corev1.EnvVar `json:"env,omitempty"`
+	// EnvFrom contains the configmaps and secrets, whose keys are passed as ENVs to the runner
+	// The explicit ENVs of the runner take precedence over them
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// PodTemplate is a pod template (metadata and spec), which is applied as a strategic merge patch on the runner pod
+	// The labels, owner references, service account and chaos ENVs of the runner can't be overridden by the template
+	PodTemplate *runtime.RawExtension `json:"podTemplate,omitempty"`
 }
 
+// RunnerType defines the backend, which launches the runner of the chaosengine
+type RunnerType string
+
+const (
+	// RunnerTypeGo launches the go based runner as a pod
+	RunnerTypeGo RunnerType = "go"
+	// RunnerTypeJob launches the go based runner as a job
+	RunnerTypeJob RunnerType = "job"
+	// RunnerTypeSimulated doesn't launch any runner, and completes the chaosengine immediately
+	RunnerTypeSimulated RunnerType = "simulated"
+	// RunnerTypeRunnerless doesn't launch any runner, the operator launches the experiment jobs itself
+	RunnerTypeRunnerless RunnerType = "runnerless"
+)
+
 // ExperimentList defines information about chaos experiments defined in the chaos engine
 // These experiments are "pulled" as versioned charts from a "hub"
 type ExperimentList struct {
@@ -337,6 +434,8 @@ type ExperimentComponents struct {
 	StatusCheckTimeouts        StatusCheckTimeout            `json:"statusCheckTimeouts,omitempty"`
 	Resources                  corev1.ResourceRequirements   `json:"resources,omitempty"`
 	Tolerations                []corev1.Toleration           `json:"tolerations,omitempty"`
+	// VolumeSources contains the emptyDir, pvc, hostPath and projected token volumes of the experiment pod
+	VolumeSources `json:",inline"`
 }
 
 // StatusCheckTimeout contains Delay and timeouts for the status checks
@@ -358,6 +457,10 @@ type ExperimentStatuses struct {
 	Status ExperimentStatus `json:"status"`
 	//Result of a completed chaos experiment
 	Verdict string `json:"verdict"`
+	//ProbeSuccessPercentage is the probe score reported in the ChaosResult
+	ProbeSuccessPercentage string `json:"probeSuccessPercentage,omitempty"`
+	//FailStep is the step at which the experiment failed, as reported in the ChaosResult
+	FailStep string `json:"failStep,omitempty"`
 	//Time of last state change of chaos experiment
 	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
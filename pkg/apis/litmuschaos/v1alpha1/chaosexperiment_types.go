@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	rbacV1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -44,12 +45,28 @@ type ConfigMap struct {
 	Data      map[string]string `json:"data,omitempty"`
 	Name      string            `json:"name"`
 	MountPath string            `json:"mountPath"`
+	// SubPath of the configmap volume, which is mounted at the mountPath
+	SubPath string `json:"subPath,omitempty"`
+	// ReadOnly mounts the configmap volume as read only
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Items projects the given keys of the configmap into the given paths
+	Items []corev1.KeyToPath `json:"items,omitempty"`
+	// DefaultMode is the mode of the files created from the configmap
+	DefaultMode *int32 `json:"defaultMode,omitempty"`
 }
 
 // Secret is an simpler implementation of corev1.Secret
 type Secret struct {
 	Name      string `json:"name"`
 	MountPath string `json:"mountPath"`
+	// SubPath of the secret volume, which is mounted at the mountPath
+	SubPath string `json:"subPath,omitempty"`
+	// ReadOnly mounts the secret volume as read only
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Items projects the given keys of the secret into the given paths
+	Items []corev1.KeyToPath `json:"items,omitempty"`
+	// DefaultMode is the mode of the files created from the secret
+	DefaultMode *int32 `json:"defaultMode,omitempty"`
 }
 
 // HostFile is an simpler implementation of corev1.HostPath, needed for experiments
@@ -58,6 +75,59 @@ type HostFile struct {
 	MountPath string              `json:"mountPath"`
 	NodePath  string              `json:"nodePath"`
 	Type      corev1.HostPathType `json:"type,omitempty"`
+	// SubPath of the hostpath volume, which is mounted at the mountPath
+	SubPath string `json:"subPath,omitempty"`
+	// ReadOnly mounts the hostpath volume as read only
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// EmptyDir is an simpler implementation of corev1.EmptyDirVolumeSource
+type EmptyDir struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	// SubPath of the emptyDir volume, which is mounted at the mountPath
+	SubPath string `json:"subPath,omitempty"`
+	// Medium of the emptyDir, which is the node's default medium if empty
+	Medium corev1.StorageMedium `json:"medium,omitempty"`
+	// SizeLimit is the maximum size of the emptyDir
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+}
+
+// PersistentVolumeClaim is an simpler implementation of corev1.PersistentVolumeClaimVolumeSource
+type PersistentVolumeClaim struct {
+	Name      string `json:"name"`
+	ClaimName string `json:"claimName"`
+	MountPath string `json:"mountPath"`
+	// SubPath of the pvc volume, which is mounted at the mountPath
+	SubPath string `json:"subPath,omitempty"`
+	// ReadOnly mounts the pvc volume as read only
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// ProjectedServiceAccountToken is an simpler implementation of corev1.ServiceAccountTokenProjection
+type ProjectedServiceAccountToken struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	// Path of the token file, relative to the mountPath
+	Path string `json:"path"`
+	// Audience of the token, which is the identifier of the apiserver if empty
+	Audience string `json:"audience,omitempty"`
+	// ExpirationSeconds is the requested validity of the token
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+	// DefaultMode is the mode of the token file
+	DefaultMode *int32 `json:"defaultMode,omitempty"`
+}
+
+// VolumeSources contains the volumes mounted in the runner or experiment pods, in addition to the configmaps and secrets
+type VolumeSources struct {
+	// EmptyDirs contains a list of EmptyDir volumes
+	EmptyDirs []EmptyDir `json:"emptyDirs,omitempty"`
+	// PersistentVolumeClaims contains a list of PersistentVolumeClaim volumes
+	PersistentVolumeClaims []PersistentVolumeClaim `json:"persistentVolumeClaims,omitempty"`
+	// HostFileVolumes defines the host directory/file to be mounted
+	HostFileVolumes []HostFile `json:"hostFileVolumes,omitempty"`
+	// ProjectedServiceAccountTokens contains a list of projected service account token volumes
+	ProjectedServiceAccountTokens []ProjectedServiceAccountToken `json:"projectedServiceAccountTokens,omitempty"`
 }
 
 // ExperimentDef defines information about nature of chaos & components subjected to it
@@ -55,6 +55,8 @@ const (
 	ResultVerdictFailed ResultVerdict = "Fail"
 	// ResultVerdictFailed is verdict of chaosresult when experiment aborted
 	ResultVerdictStopped ResultVerdict = "Stopped"
+	// ResultVerdictAwaited is verdict of chaosresult when experiment is yet to evaluate(still in running state)
+	ResultVerdictAwaited ResultVerdict = "Awaited"
 )
 
 // ChaosResultStatus defines the observed state of ChaosResult
@@ -81,10 +83,15 @@ type HistoryDetails struct {
 }
 
 // TargetDetails contains target details for the experiment and the chaos status
+// The experiments should report their targets here, the kind/name annotations are only supported for backward compatibility
 type TargetDetails struct {
-	Name        string `json:"name,omitempty"`
-	Kind        string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// ChaosStatus defines the chaos status of the target, supported values: targeted, injected, reverted
 	ChaosStatus string `json:"chaosStatus,omitempty"`
+	// LastTransitionTime is the time at which the chaos status of the target has been changed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // ProbeStatus defines information about the status and result of the probes
@@ -54,6 +54,23 @@ func (in *ChaosEngine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChaosEngineCondition) DeepCopyInto(out *ChaosEngineCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosEngineCondition.
+func (in *ChaosEngineCondition) DeepCopy() *ChaosEngineCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ChaosEngineCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosEngineList) DeepCopyInto(out *ChaosEngineList) {
 	*out = *in
@@ -99,6 +116,16 @@ func (in *ChaosEngineSpec) DeepCopyInto(out *ChaosEngineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LogArchive != nil {
+		in, out := &in.LogArchive, &out.LogArchive
+		*out = new(LogArchive)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -122,6 +149,21 @@ func (in *ChaosEngineStatus) DeepCopyInto(out *ChaosEngineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StopInitiatedTime != nil {
+		in, out := &in.StopInitiatedTime, &out.StopInitiatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ChaosEngineCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -392,6 +434,18 @@ func (in *ConfigMap) DeepCopyInto(out *ConfigMap) {
 			(*out)[key] = val
 		}
 	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.KeyToPath, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultMode != nil {
+		in, out := &in.DefaultMode, &out.DefaultMode
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -405,6 +459,27 @@ func (in *ConfigMap) DeepCopy() *ConfigMap {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmptyDir) DeepCopyInto(out *EmptyDir) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmptyDir.
+func (in *EmptyDir) DeepCopy() *EmptyDir {
+	if in == nil {
+		return nil
+	}
+	out := new(EmptyDir)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentAttributes) DeepCopyInto(out *ExperimentAttributes) {
 	*out = *in
@@ -447,7 +522,9 @@ func (in *ExperimentComponents) DeepCopyInto(out *ExperimentComponents) {
 	if in.Secrets != nil {
 		in, out := &in.Secrets, &out.Secrets
 		*out = make([]Secret, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ExperimentAnnotations != nil {
 		in, out := &in.ExperimentAnnotations, &out.ExperimentAnnotations
@@ -477,6 +554,7 @@ func (in *ExperimentComponents) DeepCopyInto(out *ExperimentComponents) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.VolumeSources.DeepCopyInto(&out.VolumeSources)
 	return
 }
 
@@ -534,7 +612,9 @@ func (in *ExperimentDef) DeepCopyInto(out *ExperimentDef) {
 	if in.Secrets != nil {
 		in, out := &in.Secrets, &out.Secrets
 		*out = make([]Secret, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.HostFileVolumes != nil {
 		in, out := &in.HostFileVolumes, &out.HostFileVolumes
@@ -653,7 +733,9 @@ func (in *HistoryDetails) DeepCopyInto(out *HistoryDetails) {
 	if in.Targets != nil {
 		in, out := &in.Targets, &out.Targets
 		*out = make([]TargetDetails, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -716,6 +798,69 @@ func (in *PostMethod) DeepCopy() *PostMethod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaim) DeepCopyInto(out *PersistentVolumeClaim) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaim.
+func (in *PersistentVolumeClaim) DeepCopy() *PersistentVolumeClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectedServiceAccountToken) DeepCopyInto(out *ProjectedServiceAccountToken) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultMode != nil {
+		in, out := &in.DefaultMode, &out.DefaultMode
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectedServiceAccountToken.
+func (in *ProjectedServiceAccountToken) DeepCopy() *ProjectedServiceAccountToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectedServiceAccountToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogArchive) DeepCopyInto(out *LogArchive) {
+	*out = *in
+	if in.TailLines != nil {
+		in, out := &in.TailLines, &out.TailLines
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogArchive.
+func (in *LogArchive) DeepCopy() *LogArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(LogArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProbeAttributes) DeepCopyInto(out *ProbeAttributes) {
 	*out = *in
@@ -835,7 +980,9 @@ func (in *RunnerInfo) DeepCopyInto(out *RunnerInfo) {
 	if in.Secrets != nil {
 		in, out := &in.Secrets, &out.Secrets
 		*out = make([]Secret, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
@@ -845,6 +992,36 @@ func (in *RunnerInfo) DeepCopyInto(out *RunnerInfo) {
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.VolumeSources.DeepCopyInto(&out.VolumeSources)
+	if in.ENV != nil {
+		in, out := &in.ENV, &out.ENV
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -861,6 +1038,18 @@ func (in *RunnerInfo) DeepCopy() *RunnerInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Secret) DeepCopyInto(out *Secret) {
 	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.KeyToPath, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultMode != nil {
+		in, out := &in.DefaultMode, &out.DefaultMode
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -927,6 +1116,10 @@ func (in *StatusCheckTimeout) DeepCopy() *StatusCheckTimeout {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetDetails) DeepCopyInto(out *TargetDetails) {
 	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -955,3 +1148,43 @@ func (in *TestStatus) DeepCopy() *TestStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSources) DeepCopyInto(out *VolumeSources) {
+	*out = *in
+	if in.EmptyDirs != nil {
+		in, out := &in.EmptyDirs, &out.EmptyDirs
+		*out = make([]EmptyDir, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PersistentVolumeClaims != nil {
+		in, out := &in.PersistentVolumeClaims, &out.PersistentVolumeClaims
+		*out = make([]PersistentVolumeClaim, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostFileVolumes != nil {
+		in, out := &in.HostFileVolumes, &out.HostFileVolumes
+		*out = make([]HostFile, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectedServiceAccountTokens != nil {
+		in, out := &in.ProjectedServiceAccountTokens, &out.ProjectedServiceAccountTokens
+		*out = make([]ProjectedServiceAccountToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSources.
+func (in *VolumeSources) DeepCopy() *VolumeSources {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSources)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigKey is the key of the operator configuration inside the configmap
+const ConfigKey = "config.yaml"
+
+// OperatorConfig contains the global defaults of the chaos-operator
+type OperatorConfig struct {
+	// ChaosRunnerImage is the image of the runner, if not provided in the chaosengine
+	ChaosRunnerImage string `json:"chaosRunnerImage,omitempty"`
+	// AnnotationKey is the annotation key used while validating the applications
+	AnnotationKey string `json:"annotationKey,omitempty"`
+	// AnnotationCheck is the annotationCheck, if not provided in the chaosengine
+	AnnotationCheck string `json:"annotationCheck,omitempty"`
+	// Analytics enables the analytics of chaos-operator, it is only read at the startup of the operator
+	Analytics bool `json:"analytics"`
+	// Runner contains the defaults of the runner pod
+	Runner RunnerDefaults `json:"runner,omitempty"`
+	// CleanupResources contains the resources, which are deleted by the chaosUID label
+	// along with the chaos pods, on abort and completion of the chaosengines
+	CleanupResources []CleanupResource `json:"cleanupResources,omitempty"`
+	// ChaosResultRetentionLimit is the maximum number of chaosresults retained in a namespace,
+	// the oldest chaosresults beyond it are deleted. It is unlimited if zero
+	ChaosResultRetentionLimit int `json:"chaosResultRetentionLimit,omitempty"`
+}
+
+// CleanupResource identifies the group, version and resource of the chaos artifacts created by the experiments
+type CleanupResource struct {
+	// Group of the resource, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Version of the resource
+	Version string `json:"version"`
+	// Resource is the plural name of the resource
+	Resource string `json:"resource"`
+}
+
+// GroupVersionResource returns the GroupVersionResource of the cleanup resource
+func (c CleanupResource) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: c.Group, Version: c.Version, Resource: c.Resource}
+}
+
+// RunnerDefaults contains the defaults of the runner pod, which are used if not provided in the chaosengine
+type RunnerDefaults struct {
+	// Resources of the runner pod
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Tolerations of the runner pod
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// NodeSelector of the runner pod
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+var (
+	current = NewDefaultConfig()
+	mutex   sync.RWMutex
+)
+
+// NewDefaultConfig returns the operator configuration derived from the operator ENVs
+func NewDefaultConfig() *OperatorConfig {
+	return &OperatorConfig{
+		ChaosRunnerImage: os.Getenv("CHAOS_RUNNER_IMAGE"),
+		AnnotationKey:    os.Getenv("CUSTOM_ANNOTATION"),
+		Analytics:        strings.ToUpper(os.Getenv("ANALYTICS")) != "FALSE",
+	}
+}
+
+// Get returns a copy of the current operator configuration
+func Get() *OperatorConfig {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return current.DeepCopy()
+}
+
+// Set replaces the current operator configuration
+func Set(config *OperatorConfig) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	current = config.DeepCopy()
+}
+
+// Parse derives the operator configuration from the data of configmap,
+// the fields which are not present in the data are taken from the operator ENVs
+func Parse(data map[string]string) (*OperatorConfig, error) {
+	config := NewDefaultConfig()
+	if err := yaml.Unmarshal([]byte(data[ConfigKey]), config); err != nil {
+		return nil, fmt.Errorf("unable to parse the operator configuration, err: %v", err)
+	}
+	return config, nil
+}
+
+// DeepCopy returns a deep copy of the operator configuration
+func (in *OperatorConfig) DeepCopy() *OperatorConfig {
+	out := *in
+	out.Runner.Resources = *in.Runner.Resources.DeepCopy()
+	if in.Runner.Tolerations != nil {
+		out.Runner.Tolerations = make([]corev1.Toleration, len(in.Runner.Tolerations))
+		for i := range in.Runner.Tolerations {
+			in.Runner.Tolerations[i].DeepCopyInto(&out.Runner.Tolerations[i])
+		}
+	}
+	if in.Runner.NodeSelector != nil {
+		out.Runner.NodeSelector = make(map[string]string, len(in.Runner.NodeSelector))
+		for key, value := range in.Runner.NodeSelector {
+			out.Runner.NodeSelector[key] = value
+		}
+	}
+	if in.CleanupResources != nil {
+		out.CleanupResources = make([]CleanupResource, len(in.CleanupResources))
+		copy(out.CleanupResources, in.CleanupResources)
+	}
+	return &out
+}
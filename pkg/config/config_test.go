@@ -0,0 +1,222 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// setEnv sets the ENVs, and returns the function which restores their previous values
+func setEnv(t *testing.T, envs map[string]string) func() {
+	var restores []func()
+	for key, value := range envs {
+		key := key
+		previous, found := os.LookupEnv(key)
+		if err := os.Setenv(key, value); err != nil {
+			t.Fatalf("Unable to set the ENV %v: %v", key, err)
+		}
+		restores = append(restores, func() {
+			if found {
+				os.Setenv(key, previous)
+			} else {
+				os.Unsetenv(key)
+			}
+		})
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]struct {
+		envs     map[string]string
+		data     map[string]string
+		expected *OperatorConfig
+		isErr    bool
+	}{
+		"Test Positive-1": {
+			envs: map[string]string{"CHAOS_RUNNER_IMAGE": "litmuschaos/chaos-runner:env", "CUSTOM_ANNOTATION": "", "ANALYTICS": ""},
+			data: map[string]string{ConfigKey: `
+chaosRunnerImage: "litmuschaos/chaos-runner:latest"
+annotationKey: "litmuschaos.io/chaos"
+annotationCheck: "true"
+analytics: false
+runner:
+  nodeSelector:
+    node: chaos
+cleanupResources:
+  - group: networking.k8s.io
+    version: v1
+    resource: networkpolicies
+chaosResultRetentionLimit: 5
+`},
+			expected: &OperatorConfig{
+				ChaosRunnerImage:          "litmuschaos/chaos-runner:latest",
+				AnnotationKey:             "litmuschaos.io/chaos",
+				AnnotationCheck:           "true",
+				Analytics:                 false,
+				Runner:                    RunnerDefaults{NodeSelector: map[string]string{"node": "chaos"}},
+				CleanupResources:          []CleanupResource{{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}},
+				ChaosResultRetentionLimit: 5,
+			},
+			isErr: false,
+		},
+		"Test Positive-2": {
+			envs: map[string]string{"CHAOS_RUNNER_IMAGE": "litmuschaos/chaos-runner:env", "CUSTOM_ANNOTATION": "litmuschaos.io/env", "ANALYTICS": "false"},
+			data: map[string]string{ConfigKey: `annotationCheck: "false"`},
+			expected: &OperatorConfig{
+				ChaosRunnerImage: "litmuschaos/chaos-runner:env",
+				AnnotationKey:    "litmuschaos.io/env",
+				AnnotationCheck:  "false",
+				Analytics:        false,
+			},
+			isErr: false,
+		},
+		"Test Positive-3": {
+			envs: map[string]string{"CHAOS_RUNNER_IMAGE": "", "CUSTOM_ANNOTATION": "", "ANALYTICS": ""},
+			data: nil,
+			expected: &OperatorConfig{
+				Analytics: true,
+			},
+			isErr: false,
+		},
+		"Test Negative-1": {
+			data:  map[string]string{ConfigKey: "chaosResultRetentionLimit: five"},
+			isErr: true,
+		},
+		"Test Negative-2": {
+			data:  map[string]string{ConfigKey: "runner: ["},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer setEnv(t, mock.envs)()
+
+			config, err := Parse(mock.data)
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if !mock.isErr && !reflect.DeepEqual(config, mock.expected) {
+				t.Fatalf("Test %q failed: expected %+v, got: %+v", name, mock.expected, config)
+			}
+		})
+	}
+}
+
+func TestNewDefaultConfig(t *testing.T) {
+	tests := map[string]struct {
+		analytics string
+		expected  bool
+	}{
+		"Test Positive-1": {
+			analytics: "",
+			expected:  true,
+		},
+		"Test Positive-2": {
+			analytics: "true",
+			expected:  true,
+		},
+		"Test Negative-1": {
+			analytics: "false",
+			expected:  false,
+		},
+		"Test Negative-2": {
+			analytics: "FALSE",
+			expected:  false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{"ANALYTICS": mock.analytics})()
+
+			if analytics := NewDefaultConfig().Analytics; analytics != mock.expected {
+				t.Fatalf("Test %q failed: expected analytics %v, got: %v", name, mock.expected, analytics)
+			}
+		})
+	}
+}
+
+func TestDeepCopy(t *testing.T) {
+	config := &OperatorConfig{
+		ChaosRunnerImage: "litmuschaos/chaos-runner:latest",
+		Runner: RunnerDefaults{
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+			Tolerations:  []corev1.Toleration{{Key: "chaos", Operator: corev1.TolerationOpExists}},
+			NodeSelector: map[string]string{"node": "chaos"},
+		},
+		CleanupResources: []CleanupResource{{Version: "v1", Resource: "services"}},
+	}
+	expected := &OperatorConfig{
+		ChaosRunnerImage: "litmuschaos/chaos-runner:latest",
+		Runner: RunnerDefaults{
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			},
+			Tolerations:  []corev1.Toleration{{Key: "chaos", Operator: corev1.TolerationOpExists}},
+			NodeSelector: map[string]string{"node": "chaos"},
+		},
+		CleanupResources: []CleanupResource{{Version: "v1", Resource: "services"}},
+	}
+
+	copied := config.DeepCopy()
+	if !reflect.DeepEqual(copied, config) {
+		t.Fatalf("Test failed: expected the copy %+v, got: %+v", config, copied)
+	}
+	copied.ChaosRunnerImage = "litmuschaos/chaos-runner:ci"
+	copied.Runner.Resources.Limits[corev1.ResourceCPU] = resource.MustParse("200m")
+	copied.Runner.Tolerations[0].Key = "other"
+	copied.Runner.NodeSelector["node"] = "other"
+	copied.CleanupResources[0].Resource = "configmaps"
+	if !reflect.DeepEqual(config, expected) {
+		t.Fatalf("Test failed: expected the original %+v to be unchanged, got: %+v", expected, config)
+	}
+}
+
+func TestGetAndSet(t *testing.T) {
+	previous := Get()
+	defer Set(previous)
+
+	config := &OperatorConfig{
+		AnnotationKey: "litmuschaos.io/chaos",
+		Runner:        RunnerDefaults{NodeSelector: map[string]string{"node": "chaos"}},
+	}
+	Set(config)
+	config.Runner.NodeSelector["node"] = "other"
+
+	current := Get()
+	if current.Runner.NodeSelector["node"] != "chaos" {
+		t.Fatalf("Test failed: expected the configuration not to be changed after Set, got: %+v", current)
+	}
+	current.AnnotationKey = "other"
+	if Get().AnnotationKey != "litmuschaos.io/chaos" {
+		t.Fatalf("Test failed: expected the configuration not to be changed by the copy of Get, got: %+v", Get())
+	}
+}
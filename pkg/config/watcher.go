@@ -0,0 +1,133 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
+)
+
+// DefaultConfigMapName is the name of the operator configmap, if not provided via CHAOS_OPERATOR_CONFIG ENV
+const DefaultConfigMapName = "chaos-operator-config"
+
+var log = logf.Log.WithName("operator_config")
+
+// Watcher watches the operator configmap and applies its changes to the operator configuration
+type Watcher struct {
+	clientSet kubernetes.Interface
+	namespace string
+	name      string
+}
+
+var _ manager.Runnable = &Watcher{}
+var _ manager.LeaderElectionRunnable = &Watcher{}
+
+// NewWatcher returns a watcher for the operator configmap inside the given namespace
+func NewWatcher(clientSet kubernetes.Interface, namespace string) *Watcher {
+	return &Watcher{
+		clientSet: clientSet,
+		namespace: namespace,
+		name:      GetConfigMapName(),
+	}
+}
+
+// GetConfigMapName returns the name of the operator configmap
+func GetConfigMapName() string {
+	if name := os.Getenv("CHAOS_OPERATOR_CONFIG"); name != "" {
+		return name
+	}
+	return DefaultConfigMapName
+}
+
+// Load reads the operator configmap and applies it, the ENV derived defaults are
+// used if the configmap is not present
+func (w *Watcher) Load() error {
+	configMap, err := w.clientSet.CoreV1().ConfigMaps(w.namespace).Get(w.name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		log.Info("Operator configmap not found, using the defaults", "Namespace", w.namespace, "Name", w.name)
+		Set(NewDefaultConfig())
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to get the operator configmap, err: %v", err)
+	}
+	return w.apply(configMap)
+}
+
+// apply parses the configmap and replaces the operator configuration,
+// an invalid configuration keeps the previous one
+func (w *Watcher) apply(configMap *corev1.ConfigMap) error {
+	config, err := Parse(configMap.Data)
+	if err != nil {
+		return err
+	}
+	Set(config)
+	log.Info("Applied the operator configuration", "Namespace", configMap.Namespace, "Name", configMap.Name, "ResourceVersion", configMap.ResourceVersion)
+	return nil
+}
+
+// Start watches the operator configmap till the stop channel is closed
+func (w *Watcher) Start(stop <-chan struct{}) error {
+	factory := informers.NewSharedInformerFactoryWithOptions(w.clientSet, 10*time.Minute,
+		informers.WithNamespace(w.namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", w.name).String()
+		}),
+	)
+	informer := factory.Core().V1().ConfigMaps().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.onChange(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			w.onChange(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			log.Info("Operator configmap deleted, using the defaults", "Namespace", w.namespace, "Name", w.name)
+			Set(NewDefaultConfig())
+		},
+	})
+	factory.Start(stop)
+	<-stop
+	return nil
+}
+
+// onChange applies the updated configmap
+func (w *Watcher) onChange(obj interface{}) {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return
+	}
+	if err := w.apply(configMap); err != nil {
+		log.Error(err, "Invalid operator configuration, keeping the previous one", "Namespace", configMap.Namespace, "Name", configMap.Name)
+	}
+}
+
+// NeedLeaderElection returns false, as every replica of the operator needs the configuration
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newOperatorConfigMap(config string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultConfigMapName, Namespace: "litmus"},
+		Data:       map[string]string{ConfigKey: config},
+	}
+}
+
+func TestGetConfigMapName(t *testing.T) {
+	tests := map[string]struct {
+		env      string
+		expected string
+	}{
+		"Test Positive-1": {
+			env:      "",
+			expected: DefaultConfigMapName,
+		},
+		"Test Positive-2": {
+			env:      "custom-operator-config",
+			expected: "custom-operator-config",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{"CHAOS_OPERATOR_CONFIG": mock.env})()
+
+			if configMapName := GetConfigMapName(); configMapName != mock.expected {
+				t.Fatalf("Test %q failed: expected %v, got: %v", name, mock.expected, configMapName)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := map[string]struct {
+		configMap     *corev1.ConfigMap
+		previous      *OperatorConfig
+		annotationKey string
+		isErr         bool
+	}{
+		"Test Positive-1": {
+			configMap:     newOperatorConfigMap(`annotationKey: "litmuschaos.io/chaos"`),
+			previous:      &OperatorConfig{AnnotationKey: "litmuschaos.io/previous"},
+			annotationKey: "litmuschaos.io/chaos",
+			isErr:         false,
+		},
+		"Test Positive-2": {
+			previous:      &OperatorConfig{AnnotationKey: "litmuschaos.io/previous"},
+			annotationKey: "litmuschaos.io/env",
+			isErr:         false,
+		},
+		"Test Negative-1": {
+			configMap:     newOperatorConfigMap("annotationKey: ["),
+			previous:      &OperatorConfig{AnnotationKey: "litmuschaos.io/previous"},
+			annotationKey: "litmuschaos.io/previous",
+			isErr:         true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{"CHAOS_OPERATOR_CONFIG": "", "CUSTOM_ANNOTATION": "litmuschaos.io/env"})()
+			defer Set(Get())
+			Set(mock.previous)
+
+			var objs []runtime.Object
+			if mock.configMap != nil {
+				objs = append(objs, mock.configMap)
+			}
+			err := NewWatcher(fake.NewSimpleClientset(objs...), "litmus").Load()
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if annotationKey := Get().AnnotationKey; annotationKey != mock.annotationKey {
+				t.Fatalf("Test %q failed: expected annotationKey %v, got: %v", name, mock.annotationKey, annotationKey)
+			}
+		})
+	}
+}
+
+func TestWatcherStart(t *testing.T) {
+	defer setEnv(t, map[string]string{"CHAOS_OPERATOR_CONFIG": "", "CUSTOM_ANNOTATION": "litmuschaos.io/env"})()
+	defer Set(Get())
+	Set(NewDefaultConfig())
+
+	clientSet := fake.NewSimpleClientset()
+	watcher := NewWatcher(clientSet, "litmus")
+	stop := make(chan struct{})
+	defer close(stop)
+	go watcher.Start(stop)
+
+	// waitForAnnotationKey waits till the change of configmap is applied by the watcher
+	waitForAnnotationKey := func(step, expected string) {
+		err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+			return Get().AnnotationKey == expected, nil
+		})
+		if err != nil {
+			t.Fatalf("Test %q failed: expected annotationKey %v, got: %v", step, expected, Get().AnnotationKey)
+		}
+	}
+
+	configMap := newOperatorConfigMap(`annotationKey: "litmuschaos.io/created"`)
+	if _, err := clientSet.CoreV1().ConfigMaps("litmus").Create(configMap); err != nil {
+		t.Fatalf("Unable to create the operator configmap: %v", err)
+	}
+	waitForAnnotationKey("create", "litmuschaos.io/created")
+
+	configMap.Data[ConfigKey] = `annotationKey: "litmuschaos.io/updated"`
+	if _, err := clientSet.CoreV1().ConfigMaps("litmus").Update(configMap); err != nil {
+		t.Fatalf("Unable to update the operator configmap: %v", err)
+	}
+	waitForAnnotationKey("update", "litmuschaos.io/updated")
+
+	if err := clientSet.CoreV1().ConfigMaps("litmus").Delete(DefaultConfigMapName, &metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Unable to delete the operator configmap: %v", err)
+	}
+	waitForAnnotationKey("delete", "litmuschaos.io/env")
+}
@@ -19,7 +19,6 @@ package chaosengine
 import (
 	"context"
 	"fmt"
-	"os"
 	"reflect"
 	"strings"
 	"time"
@@ -27,18 +26,24 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/litmuschaos/elves/kubernetes/container"
 	"github.com/litmuschaos/elves/kubernetes/pod"
-	"github.com/openebs/maya/pkg/util/retry"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -46,12 +51,11 @@ import (
 
 	"github.com/litmuschaos/chaos-operator/pkg/analytics"
 	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/chaos-operator/pkg/config"
 	"github.com/litmuschaos/chaos-operator/pkg/controller/resource"
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
 	"github.com/litmuschaos/chaos-operator/pkg/controller/utils"
 	"github.com/litmuschaos/chaos-operator/pkg/controller/watcher"
-	dynamicclientset "github.com/litmuschaos/chaos-operator/pkg/dynamic"
-	clientset "github.com/litmuschaos/chaos-operator/pkg/kubernetes"
 )
 
 const finalizer = "chaosengine.litmuschaos.io/finalizer"
@@ -63,10 +67,17 @@ type ReconcileChaosEngine struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client client.Client
-	scheme *runtime.Scheme
+	// apiReader reads objects directly from the apiserver, it is used for the namespaces outside the cache
+	apiReader client.Reader
+	scheme    *runtime.Scheme
 	// recorder is an event recorder for recording Event resources to the
 	// Kubernetes API.
 	recorder record.EventRecorder
+	// clientSet, dynamicClient and discoveryClient are created once from the manager's
+	// rest config, and shared across the reconciles
+	clientSet       kubernetes.Interface
+	dynamicClient   dynamic.Interface
+	discoveryClient discovery.CachedDiscoveryInterface
 }
 
 // reconcileEngine contains details of reconcileEngine
@@ -84,20 +95,64 @@ type podEngineRunner struct {
 // Add creates a new ChaosEngine Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager) error {
-	return add(mgr, newReconciler(mgr))
+	r, err := newReconciler(mgr)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r)
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager) reconcile.Reconciler {
-	return &ReconcileChaosEngine{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor("chaos-operator")}
+func newReconciler(mgr manager.Manager) (reconcile.Reconciler, error) {
+	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create kubernetes clientset, err: %v", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create dynamic clientset, err: %v", err)
+	}
+	return &ReconcileChaosEngine{
+		client:          mgr.GetClient(),
+		apiReader:       mgr.GetAPIReader(),
+		scheme:          mgr.GetScheme(),
+		recorder:        mgr.GetEventRecorderFor("chaos-operator"),
+		clientSet:       clientSet,
+		dynamicClient:   dynamicClient,
+		discoveryClient: memory.NewMemCacheClient(clientSet.Discovery()),
+	}, nil
+}
+
+// getReader returns the cached client if the namespace is watched by the manager, otherwise the
+// uncached reader, as the cached client can't list the resources of the namespaces outside the cache
+func (r *ReconcileChaosEngine) getReader(namespace string) client.Reader {
+	// all the namespaces are cached only if no watch namespace is provided
+	if len(chaosTypes.WatchNamespaces) == 0 {
+		return r.client
+	}
+	for _, ns := range chaosTypes.WatchNamespaces {
+		if ns == namespace {
+			return r.client
+		}
+	}
+	return r.apiReader
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
+// The reconciler doesn't keep any per-engine state, so the different engines can be reconciled concurrently
 func add(mgr manager.Manager, r reconcile.Reconciler) error {
-	c, err := controller.New("chaosengine-controller", mgr, controller.Options{Reconciler: r})
+	c, err := controller.New("chaosengine-controller", mgr, controller.Options{
+		Reconciler:              newRateLimitedReconciler(r),
+		MaxConcurrentReconciles: chaosTypes.MaxConcurrentReconciles,
+	})
 	if err != nil {
 		return err
 	}
+
+	// Index the engines by UID, to map the chaos resources back to their engine
+	if err := watcher.IndexEngineUID(mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
 	err = watchChaosResources(mgr.GetClient(), c)
 	if err != nil {
 		return err
@@ -118,6 +173,16 @@ func watchChaosResources(clientSet client.Client, c controller.Controller) error
 	if err != nil {
 		return err
 	}
+
+	err = watcher.WatchForExperimentJob(clientSet, c)
+	if err != nil {
+		return err
+	}
+
+	err = watcher.WatchForChaosResult(clientSet, c)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -145,6 +210,11 @@ func (r *ReconcileChaosEngine) Reconcile(request reconcile.Request) (reconcile.R
 		return r.reconcileForDelete(engine, request)
 	}
 
+	// Handling the termination of chaos pods of an aborted ChaosEngine
+	if engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopping {
+		return r.reconcileForDelete(engine, request)
+	}
+
 	// Start the reconcile by setting default values into ChaosEngine
 	if err := r.initEngine(engine); err != nil {
 		return reconcile.Result{}, err
@@ -160,6 +230,11 @@ func (r *ReconcileChaosEngine) Reconcile(request reconcile.Request) (reconcile.R
 		return r.reconcileForComplete(engine, request)
 	}
 
+	// Handling the chaos revert of the stopped ChaosEngine, till the chaos is reverted on all the targets
+	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateStop && engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopped && isRevertIncomplete(engine.Instance) {
+		return r.reconcileForRevert(engine, request)
+	}
+
 	// Handling forceful Abort of ChaosEngine
 	if engine.Instance.Spec.EngineState == litmuschaosv1alpha1.EngineStateStop && engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized {
 		return r.reconcileForDelete(engine, request)
@@ -188,6 +263,7 @@ func getChaosRunnerENV(cr *litmuschaosv1alpha1.ChaosEngine, aExList []string, Cl
 
 	var envDetails utils.ENVDetails
 	envDetails.SetEnv("CHAOSENGINE", cr.Name).
+		SetEnv("CHAOSENGINE_UID", string(cr.UID)).
 		SetEnv("APP_LABEL", cr.Spec.Appinfo.Applabel).
 		SetEnv("APP_KIND", cr.Spec.Appinfo.AppKind).
 		SetEnv("APP_NAMESPACE", appNS).
@@ -197,28 +273,77 @@ func getChaosRunnerENV(cr *litmuschaosv1alpha1.ChaosEngine, aExList []string, Cl
 		SetEnv("CLIENT_UUID", ClientUUID).
 		SetEnv("CHAOS_NAMESPACE", cr.Namespace).
 		SetEnv("ANNOTATION_CHECK", cr.Spec.AnnotationCheck).
-		SetEnv("ANNOTATION_KEY", resource.GetAnnotationKey())
+		SetEnv("ANNOTATION_KEY", resource.GetAnnotationKey()).
+		SetEnv("RUN_ID", cr.Status.RunID)
 
 	return envDetails.ENV
 }
 
+// getRunnerENV merges the ENVs of the runner provided in the chaosengine with the ENVs generated by the operator
+// The generated ENVs take precedence, so the runner ENVs which conflict with them or are duplicated are rejected
+func getRunnerENV(cr *litmuschaosv1alpha1.ChaosEngine, aExList []string, ClientUUID string) ([]corev1.EnvVar, error) {
+	generatedENV := getChaosRunnerENV(cr, aExList, ClientUUID)
+
+	names := map[string]bool{}
+	for _, env := range generatedENV {
+		names[env.Name] = true
+	}
+	for _, env := range cr.Spec.Components.Runner.ENV {
+		if names[env.Name] {
+			return nil, fmt.Errorf("runner ENV '%s' conflicts with the ENV generated by the operator or is duplicated", env.Name)
+		}
+		names[env.Name] = true
+	}
+	envs := make([]corev1.EnvVar, 0, len(cr.Spec.Components.Runner.ENV)+len(generatedENV))
+	envs = append(envs, cr.Spec.Components.Runner.ENV...)
+	return append(envs, generatedENV...), nil
+}
+
 // getChaosRunnerLabels return the labels required for chaos-runner
 func getChaosRunnerLabels(cr *litmuschaosv1alpha1.ChaosEngine) map[string]string {
-	return map[string]string{
+	return withRunIDLabel(cr, map[string]string{
 		"app":                         cr.Name,
 		"chaosUID":                    string(cr.UID),
 		"app.kubernetes.io/component": "chaos-runner",
 		"app.kubernetes.io/part-of":   "litmus",
+	})
+}
+
+// withRunIDLabel adds the run id of the current execution of the chaosengine to the labels, if present
+func withRunIDLabel(cr *litmuschaosv1alpha1.ChaosEngine, labels map[string]string) map[string]string {
+	if cr.Status.RunID != "" {
+		labels[watcher.ChaosRunIDLabelKey] = cr.Status.RunID
+	}
+	return labels
+}
+
+// getRunnerName returns the name of the runner of the current execution of the chaosengine
+// The chaosengines initialized before the run ids were introduced, keep the name without the run id
+func getRunnerName(cr *litmuschaosv1alpha1.ChaosEngine) string {
+	if cr.Status.RunID == "" {
+		return cr.Name + "-runner"
 	}
+	return cr.Name + "-runner-" + cr.Status.RunID
+}
+
+// newRunID generates a unique id for an execution of the chaosengine
+func newRunID() string {
+	return rand.String(6)
 }
 
 // newGoRunnerPodForCR defines a new go-based Runner Pod
 func newGoRunnerPodForCR(engine *chaosTypes.EngineInfo) (*corev1.Pod, error) {
 	engine.VolumeOpts.VolumeOperations(engine.Instance.Spec.Components.Runner.ConfigMaps, engine.Instance.Spec.Components.Runner.Secrets)
+	engine.VolumeOpts.VolumeSourceOperations(engine.Instance.Spec.Components.Runner.VolumeSources)
+
+	runnerENV, err := getRunnerENV(engine.Instance, engine.AppExperiments, analytics.ClientUUID)
+	if err != nil {
+		return nil, err
+	}
 
 	containerForRunner := container.NewBuilder().
-		WithEnvsNew(getChaosRunnerENV(engine.Instance, engine.AppExperiments, analytics.ClientUUID)).
-		WithName("chaos-runner").
+		WithEnvsNew(runnerENV).
+		WithName(runnerContainerName).
 		WithImage(engine.Instance.Spec.Components.Runner.Image).
 		WithImagePullPolicy(corev1.PullIfNotPresent)
 
@@ -238,12 +363,17 @@ func newGoRunnerPodForCR(engine *chaosTypes.EngineInfo) (*corev1.Pod, error) {
 		containerForRunner.WithCommandNew(engine.Instance.Spec.Components.Runner.Command)
 	}
 
+	// The runner defaults of the operator configuration are used, if not provided in the chaosengine
+	runnerDefaults := config.Get().Runner
+
 	if !reflect.DeepEqual(engine.Instance.Spec.Components.Runner.Resources, corev1.ResourceRequirements{}) {
 		containerForRunner.WithResourceRequirements(engine.Instance.Spec.Components.Runner.Resources)
+	} else if !reflect.DeepEqual(runnerDefaults.Resources, corev1.ResourceRequirements{}) {
+		containerForRunner.WithResourceRequirements(runnerDefaults.Resources)
 	}
 
 	podForRunner := pod.NewBuilder().
-		WithName(engine.Instance.Name + "-runner").
+		WithName(getRunnerName(engine.Instance)).
 		WithNamespace(engine.Instance.Namespace).
 		WithAnnotations(engine.Instance.Spec.Components.Runner.RunnerAnnotation).
 		WithLabels(getChaosRunnerLabels(engine.Instance)).
@@ -251,12 +381,17 @@ func newGoRunnerPodForCR(engine *chaosTypes.EngineInfo) (*corev1.Pod, error) {
 		WithRestartPolicy("OnFailure").
 		WithContainerBuilder(containerForRunner)
 
-	if engine.Instance.Spec.Components.Runner.Tolerations != nil {
+	// the empty tolerations are skipped, as the pod builder rejects them
+	if len(engine.Instance.Spec.Components.Runner.Tolerations) != 0 {
 		podForRunner.WithTolerations(engine.Instance.Spec.Components.Runner.Tolerations...)
+	} else if len(runnerDefaults.Tolerations) != 0 {
+		podForRunner.WithTolerations(runnerDefaults.Tolerations...)
 	}
 
 	if len(engine.Instance.Spec.Components.Runner.NodeSelector) != 0 {
 		podForRunner.WithNodeSelector(engine.Instance.Spec.Components.Runner.NodeSelector)
+	} else if len(runnerDefaults.NodeSelector) != 0 {
+		podForRunner.WithNodeSelector(runnerDefaults.NodeSelector)
 	}
 
 	if engine.VolumeOpts.VolumeBuilders != nil {
@@ -271,8 +406,14 @@ func newGoRunnerPodForCR(engine *chaosTypes.EngineInfo) (*corev1.Pod, error) {
 	if err != nil {
 		return podObj, err
 	}
+	podObj.Spec.Volumes = append(podObj.Spec.Volumes, engine.VolumeOpts.Volumes...)
+
+	// the envFrom sources are not supported by the container builder
+	if runnerContainer := getContainer(podObj, runnerContainerName); runnerContainer != nil {
+		runnerContainer.EnvFrom = engine.Instance.Spec.Components.Runner.EnvFrom
+	}
 
-	return podObj, nil
+	return applyRunnerPodTemplate(engine, podObj)
 }
 
 // initializeApplicationInfo to initialize application info
@@ -364,6 +505,12 @@ func (r *ReconcileChaosEngine) checkEngineRunnerPod(engine *chaosTypes.EngineInf
 		return err
 	}
 
+	// Set the chaosengine as the controller owner of runner pod, so that
+	// it gets garbage collected along with the chaosengine
+	if err := controllerutil.SetControllerReference(engine.Instance, engineRunner, r.scheme); err != nil {
+		return err
+	}
+
 	// Create an object of engine reconcile.
 	engineReconcile := &reconcileEngine{
 		r:         r,
@@ -383,11 +530,11 @@ func (r *ReconcileChaosEngine) checkEngineRunnerPod(engine *chaosTypes.EngineInf
 }
 
 //setChaosResourceImage take the runner image from engine spec
-//if it is not there then it will take from chaos-operator env
-//at last if it is not able to find image in engine spec and operator env then it will take default images
+//if it is not there then it will take from chaos-operator config
+//at last if it is not able to find image in engine spec and operator config then it will take default images
 func setChaosResourceImage(engine *chaosTypes.EngineInfo) {
 
-	ChaosRunnerImage := os.Getenv("CHAOS_RUNNER_IMAGE")
+	ChaosRunnerImage := config.Get().ChaosRunnerImage
 
 	if engine.Instance.Spec.Components.Runner.Image == "" && ChaosRunnerImage == "" {
 		engine.Instance.Spec.Components.Runner.Image = chaosTypes.DefaultChaosRunnerImage
@@ -401,7 +548,9 @@ func getAnnotationCheck(engine *chaosTypes.EngineInfo) error {
 
 	if engine.Instance.Spec.AnnotationCheck == "" {
 		engine.Instance.Spec.AnnotationCheck = chaosTypes.DefaultAnnotationCheck
-
+		if annotationCheck := config.Get().AnnotationCheck; annotationCheck != "" {
+			engine.Instance.Spec.AnnotationCheck = annotationCheck
+		}
 	}
 	if engine.Instance.Spec.AnnotationCheck != "true" && engine.Instance.Spec.AnnotationCheck != "false" {
 		return fmt.Errorf("annotationCheck '%s', is not supported it should be true or false", engine.Instance.Spec.AnnotationCheck)
@@ -410,44 +559,98 @@ func getAnnotationCheck(engine *chaosTypes.EngineInfo) error {
 }
 
 // reconcileForDelete reconciles for deletion/force deletion of Chaos Engine
+// It deletes the chaos resources and moves the engine into stopping state, which is
+// requeued till the chaos pods are terminated, instead of blocking the reconcile
 func (r *ReconcileChaosEngine) reconcileForDelete(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
 
-	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	if engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusStopping {
+		return r.reconcileForStopping(engine, request)
+	}
 
 	chaosTypes.Log.Info("Checking if there are any chaos resources to be deleted for", "chaosengine", engine.Instance.Name)
 
-	chaosPodList := &corev1.PodList{}
-	opts := []client.ListOption{
-		client.InNamespace(request.NamespacedName.Namespace),
-		client.MatchingLabels{"chaosUID": string(engine.Instance.UID)},
+	chaosPodList, err := r.getChaosPods(engine, request)
+	if err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to list chaos experiment pods")
+		return reconcile.Result{}, err
 	}
-	err := r.client.List(context.TODO(), chaosPodList, opts...)
+
+	if len(chaosPodList.Items) == 0 {
+		return r.stopEngine(engine, request, false)
+	}
+
+	chaosTypes.Log.Info("Performing a force delete of chaos experiment pods", "chaosengine", engine.Instance.Name)
+	if err := r.forceRemoveChaosResources(engine, request); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaos experiment pods")
+		return reconcile.Result{}, err
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	stopInitiatedTime := v1.Now()
+	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusStopping
+	engine.Instance.Status.StopInitiatedTime = &stopInitiatedTime
+	if err := r.client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
+		return reconcile.Result{}, fmt.Errorf("unable to update the state of chaosEngine Resource to stopping, due to error: %v", err)
+	}
+	return reconcile.Result{RequeueAfter: chaosTypes.StoppingRequeueInterval}, nil
+}
+
+// reconcileForStopping reconciles the aborted Chaos Engine, till the termination of its chaos pods
+// It stops the engine once the pods are terminated or the termination timeout is exceeded
+func (r *ReconcileChaosEngine) reconcileForStopping(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
+
+	chaosPodList, err := r.getChaosPods(engine, request)
 	if err != nil {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to list chaos experiment pods")
 		return reconcile.Result{}, err
 	}
 
 	if len(chaosPodList.Items) != 0 {
-		chaosTypes.Log.Info("Performing a force delete of chaos experiment pods", "chaosengine", engine.Instance.Name)
-		err := r.forceRemoveChaosResources(engine, request)
-		if err != nil {
-			r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaos experiment pods")
-			return reconcile.Result{}, err
+		if !isTerminationTimedOut(engine) {
+			chaosTypes.Log.Info("Waiting for the termination of chaos pods", "chaosengine", engine.Instance.Name, "pods", len(chaosPodList.Items))
+			return reconcile.Result{RequeueAfter: chaosTypes.StoppingRequeueInterval}, nil
 		}
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesTerminationTimeout", "(chaos stop) %d chaos pods are not terminated within %v", len(chaosPodList.Items), chaosTypes.TerminationTimeout)
 	}
+	return r.stopEngine(engine, request, true)
+}
+
+// stopEngine updates the chaos status in the chaosresult, removes the finalizer and marks the engine as stopped
+func (r *ReconcileChaosEngine) stopEngine(engine *chaosTypes.EngineInfo, request reconcile.Request, chaosResourcesDeleted bool) (reconcile.Result, error) {
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
 
 	// update the chaos status in result for abort cases
 	if err := r.updateChaosStatus(engine, request); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if engine.Instance.ObjectMeta.Finalizers != nil {
+	// flag the engine, if the chaos is not reverted on any target after abort
+	reverted, err := r.verifyChaosRevert(engine)
+	if err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to verify the chaos revert: %v", err)
+	}
+
+	// delete the chaosresults of the deleted engine as per the chaosResultCleanUpPolicy
+	if err := r.removeChaosResults(engine); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaosresults")
+		return reconcile.Result{}, err
+	}
+	if err := r.pruneChaosResults(engine); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to prune chaosresults: %v", err)
+	}
+
+	// the finalizer is retained for the delete chaosResultCleanUpPolicy, so that the chaosresults are deleted along with the engine
+	if engine.Instance.ObjectMeta.Finalizers != nil && !isChaosResultRemovalPending(engine.Instance) {
 		engine.Instance.ObjectMeta.Finalizers = utils.RemoveString(engine.Instance.ObjectMeta.Finalizers, "chaosengine.litmuschaos.io/finalizer")
 	}
 
 	// Update ChaosEngine ExperimentStatuses, with aborted Status.
 	updateExperimentStatusesForStop(engine)
+	engine.Instance.Status.Verdict = getEngineVerdict(engine.Instance)
 	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusStopped
+	engine.Instance.Status.StopInitiatedTime = nil
 
 	if err := r.client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
@@ -456,39 +659,103 @@ func (r *ReconcileChaosEngine) reconcileForDelete(engine *chaosTypes.EngineInfo,
 
 	//we are repeating this condition/check here as we want the events for 'ChaosEngineStopped'
 	//generated only after successful finalizer removal from the chaosengine resource
-	if len(chaosPodList.Items) != 0 {
+	if chaosResourcesDeleted {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosEngineStopped", "Chaos resources deleted successfully")
 	} else {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosEngineStopped", "Chaos stopped due to failed app identification")
 	}
 
+	// the chaos status of the targets is re-evaluated, as the helper pods may still be reverting the chaos
+	if !reverted && engine.Instance.DeletionTimestamp == nil {
+		return reconcile.Result{RequeueAfter: chaosTypes.RevertCheckInterval}, nil
+	}
 	return reconcile.Result{}, nil
+}
 
+// getChaosPods lists the chaos pods of the chaosengine across all the namespaces,
+// as the helper pods of the experiments can be created in the namespace of the application.
+// They are listed through the uncached reader, if the manager doesn't cache all the namespaces
+func (r *ReconcileChaosEngine) getChaosPods(engine *chaosTypes.EngineInfo, request reconcile.Request) (*corev1.PodList, error) {
+	selector, err := getChaosResourceSelector(map[string]string{"chaosUID": string(engine.Instance.UID)})
+	if err != nil {
+		return nil, err
+	}
+	chaosPodList := &corev1.PodList{}
+	if err := r.getReader(v1.NamespaceAll).List(context.TODO(), chaosPodList, selector); err != nil {
+		return nil, err
+	}
+	return chaosPodList, nil
+}
+
+// getChaosResourceSelector selects the chaos resources with the given labels, except the log archiver pods,
+// which are removed only after the logs are copied
+func getChaosResourceSelector(set map[string]string) (client.MatchingLabelsSelector, error) {
+	requirement, err := labels.NewRequirement("app.kubernetes.io/component", selection.NotIn, []string{logArchiveComponent})
+	if err != nil {
+		return client.MatchingLabelsSelector{}, err
+	}
+	return client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(set).Add(*requirement)}, nil
 }
 
-// forceRemoveAllChaosPods force removes all chaos-related pods
+// isTerminationTimedOut checks whether the chaos pods of the aborted engine
+// have exceeded the termination timeout
+func isTerminationTimedOut(engine *chaosTypes.EngineInfo) bool {
+	if engine.Instance.Status.StopInitiatedTime == nil {
+		return true
+	}
+	return time.Since(engine.Instance.Status.StopInitiatedTime.Time) > chaosTypes.TerminationTimeout
+}
+
+// forceRemoveAllChaosPods force removes all chaos-related pods and jobs, from all the namespaces touched by the chaosengine
 func (r *ReconcileChaosEngine) forceRemoveAllChaosPods(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
-	optsDelete := []client.DeleteAllOfOption{client.InNamespace(request.NamespacedName.Namespace), client.MatchingLabels{"chaosUID": string(engine.Instance.UID)}, client.PropagationPolicy(v1.DeletePropagationBackground)}
-	if engine.Instance.Spec.TerminationGracePeriodSeconds != 0 {
-		optsDelete = append(optsDelete, client.GracePeriodSeconds(engine.Instance.Spec.TerminationGracePeriodSeconds))
+	chaosPodList, errList := r.getChaosPods(engine, request)
+	if errList != nil {
+		return errList
+	}
+	chaosJobList, errList := r.getChaosJobs(engine)
+	if errList != nil {
+		return errList
+	}
+
+	selector, errSelector := getChaosResourceSelector(map[string]string{"chaosUID": string(engine.Instance.UID)})
+	if errSelector != nil {
+		return errSelector
 	}
 
 	var deleteEvent []string
 	var err []error
 
-	if errJob := r.client.DeleteAllOf(context.TODO(), &batchv1.Job{}, optsDelete...); errJob != nil {
-		err = append(err, errJob)
-		deleteEvent = append(deleteEvent, "Jobs, ")
+	for _, namespace := range getChaosNamespaces(engine, chaosPodList, chaosJobList) {
+		optsDelete := []client.DeleteAllOfOption{client.InNamespace(namespace), selector, client.PropagationPolicy(v1.DeletePropagationBackground)}
+		if engine.Instance.Spec.TerminationGracePeriodSeconds != 0 {
+			optsDelete = append(optsDelete, client.GracePeriodSeconds(engine.Instance.Spec.TerminationGracePeriodSeconds))
+		}
+
+		if errJob := r.client.DeleteAllOf(context.TODO(), &batchv1.Job{}, optsDelete...); errJob != nil {
+			err = append(err, errJob)
+			deleteEvent = append(deleteEvent, fmt.Sprintf("Jobs in %s, ", namespace))
+		}
+
+		if errPod := r.client.DeleteAllOf(context.TODO(), &corev1.Pod{}, optsDelete...); errPod != nil {
+			err = append(err, errPod)
+			deleteEvent = append(deleteEvent, fmt.Sprintf("Pods in %s, ", namespace))
+		}
 	}
 
-	if errPod := r.client.DeleteAllOf(context.TODO(), &corev1.Pod{}, optsDelete...); errPod != nil {
-		err = append(err, errPod)
-		deleteEvent = append(deleteEvent, "Pods, ")
+	if errConfigMap := r.removeInlineConfigMaps(engine); errConfigMap != nil {
+		err = append(err, errConfigMap)
+		deleteEvent = append(deleteEvent, "ConfigMaps, ")
 	}
 	if err != nil {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaos resources: %v allocated to chaosengine", strings.Join(deleteEvent, ""))
 		return fmt.Errorf("unable to delete ChaosResources due to %v", err)
 	}
+	r.reportRemovedChaosResources(engine, chaosPodList, chaosJobList)
+
+	// the failed deletion of chaos artifacts is reported, without blocking the abort of chaosengine
+	if errArtifacts := r.removeChaosArtifacts(engine); errArtifacts != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to delete chaos artifacts: %v", errArtifacts)
+	}
 	return nil
 }
 
@@ -506,11 +773,11 @@ func (r *ReconcileChaosEngine) updateEngineState(engine *chaosTypes.EngineInfo,
 func (r *ReconcileChaosEngine) checkRunnerContainerCompletedStatus(engine *chaosTypes.EngineInfo) bool {
 	runnerPod := corev1.Pod{}
 	isCompleted := false
-	r.client.Get(context.TODO(), types.NamespacedName{Name: engine.Instance.Name + "-runner", Namespace: engine.Instance.Namespace}, &runnerPod)
+	r.client.Get(context.TODO(), types.NamespacedName{Name: getRunnerName(engine.Instance), Namespace: engine.Instance.Namespace}, &runnerPod)
 
 	if runnerPod.Status.Phase == corev1.PodRunning || runnerPod.Status.Phase == corev1.PodSucceeded {
 		for _, container := range runnerPod.Status.ContainerStatuses {
-			if container.Name == "chaos-runner" && container.State.Terminated != nil {
+			if container.Name == runnerContainerName && container.State.Terminated != nil {
 				if container.State.Terminated.Reason == "Completed" {
 					isCompleted = !container.Ready
 				}
@@ -521,49 +788,138 @@ func (r *ReconcileChaosEngine) checkRunnerContainerCompletedStatus(engine *chaos
 	return isCompleted
 }
 
-// gracefullyRemoveDefaultChaosResources removes all chaos-resources gracefully
+// gracefullyRemoveDefaultChaosResources removes all chaos-resources gracefully, as per the jobCleanUpPolicy
+// The retained chaos-resources are removed after the ttlSecondsAfterFinished, the engine is requeued till then
+// The chaos-resources are also retained while the verdict is awaited, the engine is requeued till the verdict is final
 func (r *ReconcileChaosEngine) gracefullyRemoveDefaultChaosResources(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
 
-	if engine.Instance.Spec.JobCleanUpPolicy == litmuschaosv1alpha1.CleanUpPolicyDelete {
-		if err := r.gracefullyRemoveChaosPods(engine, request); err != nil {
-			return reconcile.Result{}, err
+	if isChaosResourcesRetained(engine.Instance) {
+		var requeueAfter time.Duration
+		if isVerdictAwaited(engine.Instance) {
+			requeueAfter = chaosTypes.VerdictRequeueInterval
+		}
+		if engine.Instance.Spec.TTLSecondsAfterFinished == nil {
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if remainingTTL := getRemainingTTL(engine.Instance); remainingTTL > 0 {
+			if requeueAfter == 0 || remainingTTL < requeueAfter {
+				requeueAfter = remainingTTL
+			}
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
 		}
 	}
+	if err := r.gracefullyRemoveChaosPods(engine, request); err != nil {
+		return reconcile.Result{}, err
+	}
 	return reconcile.Result{}, nil
 }
 
+// isChaosResourcesRetained checks whether the chaos-resources of the completed engine are retained as per the jobCleanUpPolicy
+// The retainOnFailure policy retains them only if any experiment has failed, to debug the failure
+// and till the verdict is final, as the awaited experiments can still fail
+func isChaosResourcesRetained(instance *litmuschaosv1alpha1.ChaosEngine) bool {
+	switch instance.Spec.JobCleanUpPolicy {
+	case litmuschaosv1alpha1.CleanUpPolicyDelete:
+		return false
+	case litmuschaosv1alpha1.CleanUpPolicyRetainOnFailure:
+		return getEngineVerdict(instance) == litmuschaosv1alpha1.ResultVerdictFailed || isVerdictAwaited(instance)
+	default:
+		return true
+	}
+}
+
+// isVerdictAwaited checks whether the retainOnFailure policy is waiting for the final verdict of the engine
+func isVerdictAwaited(instance *litmuschaosv1alpha1.ChaosEngine) bool {
+	return instance.Spec.JobCleanUpPolicy == litmuschaosv1alpha1.CleanUpPolicyRetainOnFailure &&
+		getEngineVerdict(instance) == litmuschaosv1alpha1.ResultVerdictAwaited
+}
+
+// getRemainingTTL returns the duration, after which the retained chaos-resources of the completed engine are removed
+// The engines completed without the completion time are considered to be expired
+func getRemainingTTL(instance *litmuschaosv1alpha1.ChaosEngine) time.Duration {
+	if instance.Status.CompletionTime == nil || instance.Spec.TTLSecondsAfterFinished == nil {
+		return 0
+	}
+	expiryTime := instance.Status.CompletionTime.Add(time.Duration(*instance.Spec.TTLSecondsAfterFinished) * time.Second)
+	return time.Until(expiryTime)
+}
+
 // gracefullyRemoveChaosPods removes chaos default resources gracefully
 func (r *ReconcileChaosEngine) gracefullyRemoveChaosPods(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
 
-	optsList := []client.ListOption{
-		client.InNamespace(request.NamespacedName.Namespace), client.MatchingLabels{"app": engine.Instance.Name, "chaosUID": string(engine.Instance.UID)},
+	// the chaos pods and jobs are listed across all the namespaces touched by the chaosengine
+	selector, err := getChaosResourceSelector(map[string]string{"app": engine.Instance.Name, "chaosUID": string(engine.Instance.UID)})
+	if err != nil {
+		return err
 	}
+	optsList := []client.ListOption{selector}
 	var podList corev1.PodList
-	if errList := r.client.List(context.TODO(), &podList, optsList...); errList != nil {
+	if errList := r.getReader(v1.NamespaceAll).List(context.TODO(), &podList, optsList...); errList != nil {
 		return errList
 	}
+
+	// the logs are archived before the deletion of the chaos pods, and a failed archival doesn't block the cleanup
+	if err := r.archiveChaosLogs(engine, &podList); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosLogArchiveFailed", "Unable to archive the logs of chaos pods: %v", err)
+	}
 	for _, v := range podList.Items {
 		if errDel := r.client.Delete(context.TODO(), &v, []client.DeleteOption{}...); errDel != nil {
 			return errDel
 		}
 	}
-	return nil
+
+	// the runner job of the job runner type carries the same labels as the runner pod
+	var jobList batchv1.JobList
+	if errList := r.getReader(v1.NamespaceAll).List(context.TODO(), &jobList, optsList...); errList != nil {
+		return errList
+	}
+	for _, v := range jobList.Items {
+		if errDel := r.client.Delete(context.TODO(), &v, client.PropagationPolicy(v1.DeletePropagationBackground)); errDel != nil {
+			return errDel
+		}
+	}
+	r.reportRemovedChaosResources(engine, &podList, &jobList)
+
+	// the failed deletion of chaos artifacts is reported, without blocking the completion of chaosengine
+	if errArtifacts := r.removeChaosArtifacts(engine); errArtifacts != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to delete chaos artifacts: %v", errArtifacts)
+	}
+	return r.removeInlineConfigMaps(engine)
 }
 
 // reconcileForComplete reconciles for graceful completion of Chaos Engine
 func (r *ReconcileChaosEngine) reconcileForComplete(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
 
-	_, err := r.gracefullyRemoveDefaultChaosResources(engine, request)
+	if err := r.updateEngineVerdict(engine); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to update verdict of chaosengine")
+		return reconcile.Result{}, err
+	}
+
+	result, err := r.gracefullyRemoveDefaultChaosResources(engine, request)
 	if err != nil {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to delete chaos pods upon chaos completion")
 		return reconcile.Result{}, err
 	}
+
+	// the chaosresults beyond the retention limit of the namespace are pruned, without blocking the completion
+	if err := r.pruneChaosResults(engine); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to prune chaosresults: %v", err)
+	}
+
+	// the completed engine is requeued till the archiver pod has copied the logs into the pvc
+	archiving, err := r.reconcileLogArchiver(engine)
+	if err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosLogArchiveFailed", "Unable to archive the logs of chaos pods: %v", err)
+	}
+	if archiving && (result.RequeueAfter == 0 || result.RequeueAfter > chaosTypes.LogArchiveRequeueInterval) {
+		result.RequeueAfter = chaosTypes.LogArchiveRequeueInterval
+	}
 	err = r.updateEngineState(engine, litmuschaosv1alpha1.EngineStateStop)
 	if err != nil {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos completion) Unable to update chaosengine")
 		return reconcile.Result{}, fmt.Errorf("unable to Update Engine State: %v", err)
 	}
-	return reconcile.Result{}, nil
+	return result, nil
 }
 
 // reconcileForRestartAfterAbort reconciles for restart of ChaosEngine after it was aborted previously
@@ -591,6 +947,8 @@ func (r *ReconcileChaosEngine) reconcileForRestartAfterComplete(engine *chaosTyp
 
 	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusInitialized
 	engine.Instance.Status.Experiments = nil
+	engine.Instance.Status.CompletionTime = nil
+	engine.Instance.Status.Conditions = nil
 
 	// finalizers have been retained in a completed chaosengine till this point (as chaos pods may be "retained")
 	// as per the jobCleanUpPolicy. Stale finalizer is removed so that initEngine() generates the
@@ -619,11 +977,13 @@ func (r *ReconcileChaosEngine) initEngine(engine *chaosTypes.EngineInfo) error {
 	if engine.Instance.Status.EngineStatus == litmuschaosv1alpha1.EngineStatusInitialized {
 		if engine.Instance.ObjectMeta.Finalizers == nil {
 			engine.Instance.ObjectMeta.Finalizers = append(engine.Instance.ObjectMeta.Finalizers, finalizer)
+			// each execution of the chaosengine gets a new run id, as the finalizer is removed on stop and restart
+			engine.Instance.Status.RunID = newRunID()
 			if err := r.client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
 				return fmt.Errorf("unable to initialize ChaosEngine, because of Update Error: %v", err)
 			}
 			// generate the ChaosEngineInitialized event once finalizer has been added
-			r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosEngineInitialized", "Identifying app under test & launching %s", engine.Instance.Name+"-runner")
+			r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosEngineInitialized", "Identifying app under test & launching %s", getRunnerName(engine.Instance))
 		}
 	}
 	return nil
@@ -642,14 +1002,32 @@ func (r *ReconcileChaosEngine) reconcileForCreationAndRunning(engine *chaosTypes
 		return reconcile.Result{}, err
 	}
 
-	//Check if the engineRunner pod already exists, else create
-	err = r.checkEngineRunnerPod(engine, reqLogger)
+	// Select the runner backend from the runner type of the chaosengine
+	runner, err := getRunnerBackend(engine)
+	if err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) %v", err)
+		return reconcile.Result{}, err
+	}
+
+	// Create the configmaps from the inline data of the runner and experiments
+	if err := r.createInlineConfigMaps(engine); err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to create configmaps from inline data: %v", err)
+		return reconcile.Result{}, err
+	}
+
+	//Check if the engineRunner already exists, else create
+	err = runner.launch(r, engine, reqLogger)
 	if err != nil {
 		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos start) Unable to get chaos resources")
 		return reconcile.Result{}, err
 	}
 
-	isCompleted := r.checkRunnerContainerCompletedStatus(engine)
+	// roll up the verdicts of the chaosresults into the chaosengine
+	if err := r.updateEngineVerdict(engine); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	isCompleted := runner.isCompleted(r, engine)
 	if isCompleted {
 		err := r.updateEngineForComplete(engine, isCompleted)
 		if err != nil {
@@ -681,18 +1059,8 @@ func (r *ReconcileChaosEngine) validateAnnontatedApplication(engine *chaosTypes.
 	// Get the image for runner pod from chaosengine spec,operator env or default values.
 	setChaosResourceImage(engine)
 
-	clientSet, err := clientset.CreateClientSet()
-	if err != nil {
-		return err
-	}
-
-	dynamicClient, err := dynamicclientset.CreateClientSet()
-	if err != nil {
-		return err
-	}
-
 	//getAnnotationCheck fetch the annotationCheck from engine spec
-	err = getAnnotationCheck(engine)
+	err := getAnnotationCheck(engine)
 	if err != nil {
 		return err
 	}
@@ -711,7 +1079,8 @@ func (r *ReconcileChaosEngine) validateAnnontatedApplication(engine *chaosTypes.
 			return errors.Errorf("incomplete AppInfo inside chaosengine")
 		}
 		// Determine whether apps with matching labels have chaos annotation set to true
-		engine, err = resource.CheckChaosAnnotation(engine, clientSet, *dynamicClient)
+		// The native apps are read through the informer cache of the manager, if their namespace is watched
+		engine, err = resource.CheckChaosAnnotation(engine, r.getReader(engine.AppInfo.Namespace), r.dynamicClient)
 		if err != nil {
 			//using an event msg that indicates the app couldn't be identified. By this point in execution,
 			//if the engine could not be found or accessed, it would already be caught in r.initEngine & getApplicationDetail
@@ -725,7 +1094,9 @@ func (r *ReconcileChaosEngine) validateAnnontatedApplication(engine *chaosTypes.
 
 func (r *ReconcileChaosEngine) updateEngineForComplete(engine *chaosTypes.EngineInfo, isCompleted bool) error {
 	if engine.Instance.Status.EngineStatus != litmuschaosv1alpha1.EngineStatusCompleted {
+		completionTime := v1.Now()
 		engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusCompleted
+		engine.Instance.Status.CompletionTime = &completionTime
 		engine.Instance.Spec.EngineState = litmuschaosv1alpha1.EngineStateStop
 		if err := r.client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
 			return fmt.Errorf("unable to update ChaosEngine Status, due to update error: %v", err)
@@ -739,6 +1110,12 @@ func (r *ReconcileChaosEngine) updateEngineForRestart(engine *chaosTypes.EngineI
 	r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "RestartInProgress", "ChaosEngine is restarted")
 	engine.Instance.Status.EngineStatus = litmuschaosv1alpha1.EngineStatusInitialized
 	engine.Instance.Status.Experiments = nil
+	engine.Instance.Status.CompletionTime = nil
+	engine.Instance.Status.Conditions = nil
+	// the finalizer retained for the chaosResultCleanUpPolicy is removed, so that initEngine() re-adds it with a new run id
+	if engine.Instance.ObjectMeta.Finalizers != nil {
+		engine.Instance.ObjectMeta.Finalizers = utils.RemoveString(engine.Instance.ObjectMeta.Finalizers, finalizer)
+	}
 	if err := r.client.Update(context.TODO(), engine.Instance, &client.UpdateOptions{}); err != nil {
 		return fmt.Errorf("unable to restart ChaosEngine, due to update error: %v", err)
 	}
@@ -757,87 +1134,69 @@ func (r *ReconcileChaosEngine) forceRemoveChaosResources(engine *chaosTypes.Engi
 // updateChaosStatus update the chaos status inside the chaosresult
 func (r *ReconcileChaosEngine) updateChaosStatus(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
 
-	if err := r.waitForChaosPodTermination(engine, request); err != nil {
+	// the CRD is validated through discovery, which doesn't need any cluster scoped permissions.
+	// So it is validated irrespective of the watch namespaces of the operator
+	found, err := r.isResultCRDAvailable()
+	if err != nil {
 		return err
 	}
-
-	// skipping CRD validation for the namespace scoped operator
-	if os.Getenv("WATCH_NAMESPACE") == "" {
-		found, err := isResultCRDAvailable()
-		if err != nil {
-			return err
-		}
-		if !found {
-			return nil
-		}
+	if !found {
+		return nil
 	}
-	return r.updatChaosResult(engine, request)
+	return r.updatChaosResult(engine)
 }
 
 // updatChaosResult update the chaosstatus and annotation inside the chaosresult
-func (r *ReconcileChaosEngine) updatChaosResult(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
-
-	chaosresultList := &litmuschaosv1alpha1.ChaosResultList{}
-	opts := []client.ListOption{
-		client.InNamespace(request.NamespacedName.Namespace),
-		client.MatchingLabels{},
-	}
-
-	if err := r.client.List(context.TODO(), chaosresultList, opts...); err != nil {
+func (r *ReconcileChaosEngine) updatChaosResult(engine *chaosTypes.EngineInfo) error {
+	chaosresultList, err := r.getChaosResultsForEngine(engine)
+	if err != nil {
 		return err
 	}
 
-	for _, result := range chaosresultList.Items {
-		if result.Labels["chaosUID"] == string(engine.Instance.UID) {
-			targetsList, annotations := getChaosStatus(result)
-			result.Status.History.Targets = targetsList
-			result.ObjectMeta.Annotations = annotations
+	// all the chaosresults of the engine are updated, and the failures are aggregated into a single error
+	var failures []string
+	for i := range chaosresultList.Items {
+		result := &chaosresultList.Items[i]
+		targetsList, annotations := getChaosStatus(*result)
+		result.Status.History.Targets = targetsList
+		result.ObjectMeta.Annotations = annotations
 
-			chaosTypes.Log.Info("updating chaos status inside chaosresult", "chaosresult", result.Name)
-			return r.client.Update(context.TODO(), &result, &client.UpdateOptions{})
+		chaosTypes.Log.Info("updating chaos status inside chaosresult", "chaosresult", result.Name)
+		if err := r.client.Update(context.TODO(), result, &client.UpdateOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			failures = append(failures, fmt.Sprintf("unable to update chaosresult '%s', err: %v", result.Name, err))
 		}
 	}
-	return nil
-}
-
-// waitForChaosPodTermination wait until the termination of chaos pod after abort
-func (r *ReconcileChaosEngine) waitForChaosPodTermination(engine *chaosTypes.EngineInfo, request reconcile.Request) error {
-	opts := []client.ListOption{
-		client.InNamespace(request.NamespacedName.Namespace),
-		client.MatchingLabels{"chaosUID": string(engine.Instance.UID)},
+	if len(failures) != 0 {
+		return fmt.Errorf("%s", strings.Join(failures, "; "))
 	}
-
-	return retry.
-		Times(uint(180)).
-		Wait(1 * time.Second).
-		Try(func(attempt uint) error {
-			chaosPodList := &corev1.PodList{}
-			if err := r.client.List(context.TODO(), chaosPodList, opts...); err != nil {
-				return err
-			}
-			if len(chaosPodList.Items) != 0 {
-				return errors.Errorf("chaos pods are not deleted yet")
-			}
-			return nil
-		})
+	return nil
 }
 
 // getChaosStatus return the target application details along with their chaos status
+// The targets reported through the legacy kind/name annotations are merged into the structured
+// targets of the chaosresult, and the merged annotations are removed from the returned annotations
 func getChaosStatus(result litmuschaosv1alpha1.ChaosResult) ([]litmuschaosv1alpha1.TargetDetails, map[string]string) {
-	annotations := result.ObjectMeta.Annotations
+	annotations := map[string]string{}
+	for k, v := range result.ObjectMeta.Annotations {
+		annotations[k] = v
+	}
+
+	targetsList := make([]litmuschaosv1alpha1.TargetDetails, 0, len(result.Status.History.Targets))
+	for _, target := range result.Status.History.Targets {
+		targetsList = append(targetsList, *target.DeepCopy())
+	}
 
-	targetsList := result.Status.History.Targets
-	for k, v := range annotations {
+	for k, v := range result.ObjectMeta.Annotations {
 		switch strings.ToLower(v) {
 		case "injected", "reverted", "targeted":
-			kind := strings.TrimSpace(strings.Split(k, "/")[0])
-			name := strings.TrimSpace(strings.Split(k, "/")[1])
-			if !updateTargets(name, v, &targetsList) {
-				targetsList = append(targetsList, litmuschaosv1alpha1.TargetDetails{
-					Name:        name,
-					Kind:        kind,
-					ChaosStatus: v,
-				})
+			target, ok := parseTargetAnnotation(k, v)
+			if !ok {
+				continue
+			}
+			if !updateTargets(target, &targetsList) {
+				now := v1.Now()
+				target.LastTransitionTime = &now
+				targetsList = append(targetsList, target)
 			}
 			delete(annotations, k)
 		}
@@ -845,29 +1204,51 @@ func getChaosStatus(result litmuschaosv1alpha1.ChaosResult) ([]litmuschaosv1alph
 	return targetsList, annotations
 }
 
-// isResultCRDAvailable check the existance of chaosresult CRD inside cluster
-func isResultCRDAvailable() (bool, error) {
-
-	dynamicClient, err := dynamicclientset.CreateClientSet()
-	if err != nil {
-		return false, err
+// parseTargetAnnotation derives the target from the legacy annotation, whose key is either kind/name or kind/namespace/name
+// It returns false for the keys of any other format, so that the unrelated annotations are retained as it is
+func parseTargetAnnotation(key, status string) (litmuschaosv1alpha1.TargetDetails, bool) {
+	parts := strings.Split(key, "/")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+		if parts[i] == "" {
+			return litmuschaosv1alpha1.TargetDetails{}, false
+		}
 	}
 
-	//defining the gvr for the requested resource
-	gvr := schema.GroupVersionResource{
-		Group:    "apiextensions.k8s.io",
-		Version:  "v1",
-		Resource: "customresourcedefinitions",
+	switch len(parts) {
+	case 2:
+		return litmuschaosv1alpha1.TargetDetails{Kind: parts[0], Name: parts[1], ChaosStatus: status}, true
+	case 3:
+		return litmuschaosv1alpha1.TargetDetails{Kind: parts[0], Namespace: parts[1], Name: parts[2], ChaosStatus: status}, true
+	default:
+		return litmuschaosv1alpha1.TargetDetails{}, false
 	}
+}
+
+// isResultCRDAvailable check the existance of chaosresult CRD inside cluster
+func (r *ReconcileChaosEngine) isResultCRDAvailable() (bool, error) {
+	found, err := isResultResourceServed(r.discoveryClient)
+	if err != nil || found {
+		return found, err
+	}
+	// the discovery cache may be stale, if the CRD is installed after caching
+	r.discoveryClient.Invalidate()
+	return isResultResourceServed(r.discoveryClient)
+}
 
-	resultList, err := (*dynamicClient).Resource(gvr).List(v1.ListOptions{})
+// isResultResourceServed check whether the chaosresult resource is served by the apiserver
+func isResultResourceServed(discoveryClient discovery.DiscoveryInterface) (bool, error) {
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(litmuschaosv1alpha1.SchemeGroupVersion.String())
 	if err != nil {
+		if err == memory.ErrCacheNotFound || k8serrors.IsNotFound(err) {
+			return false, nil
+		}
 		return false, err
 	}
 
 	// it will check the presence of chaosresult CRD inside cluster
-	for _, crd := range resultList.Items {
-		if crd.GetName() == chaosTypes.ResultCRDName {
+	for _, apiResource := range resourceList.APIResources {
+		if apiResource.Name+"."+litmuschaosv1alpha1.SchemeGroupVersion.Group == chaosTypes.ResultCRDName {
 			return true, nil
 		}
 	}
@@ -875,12 +1256,25 @@ func isResultCRDAvailable() (bool, error) {
 }
 
 // updates the chaos status of targets which is already present inside historyt.targets
-func updateTargets(name, status string, data *[]litmuschaosv1alpha1.TargetDetails) bool {
+// The namespace of the target is matched only if it is known, as the kind/name annotations don't contain it
+func updateTargets(target litmuschaosv1alpha1.TargetDetails, data *[]litmuschaosv1alpha1.TargetDetails) bool {
 	for i := range *data {
-		if (*data)[i].Name == name {
-			(*data)[i].ChaosStatus = status
-			return true
+		existing := &(*data)[i]
+		if existing.Name != target.Name || !strings.EqualFold(existing.Kind, target.Kind) {
+			continue
+		}
+		if target.Namespace != "" && existing.Namespace != "" && existing.Namespace != target.Namespace {
+			continue
+		}
+		if existing.Namespace == "" {
+			existing.Namespace = target.Namespace
+		}
+		if !strings.EqualFold(existing.ChaosStatus, target.ChaosStatus) || existing.LastTransitionTime == nil {
+			now := v1.Now()
+			existing.LastTransitionTime = &now
 		}
+		existing.ChaosStatus = target.ChaosStatus
+		return true
 	}
 	return false
 }
@@ -16,17 +16,28 @@ package chaosengine
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/litmuschaos/chaos-operator/pkg/config"
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery/cached/memory"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	litmusFakeClientset "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -81,6 +92,7 @@ func TestInitializeApplicationInfo(t *testing.T) {
 }
 func TestGetChaosRunnerENV(t *testing.T) {
 	fakeEngineName := "Fake Engine"
+	fakeEngineUID := "fake-engine-uid"
 	fakeNameSpace := "Fake NameSpace"
 	fakeServiceAcc := "Fake Service Account"
 	fakeAppLabel := "Fake Label"
@@ -101,6 +113,7 @@ func TestGetChaosRunnerENV(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      fakeEngineName,
 					Namespace: fakeNameSpace,
+					UID:       types.UID(fakeEngineUID),
 				},
 				Spec: v1alpha1.ChaosEngineSpec{
 					ChaosServiceAccount: fakeServiceAcc,
@@ -119,6 +132,10 @@ func TestGetChaosRunnerENV(t *testing.T) {
 					Name:  "CHAOSENGINE",
 					Value: fakeEngineName,
 				},
+				{
+					Name:  "CHAOSENGINE_UID",
+					Value: fakeEngineUID,
+				},
 				{
 					Name:  "APP_LABEL",
 					Value: fakeAppLabel,
@@ -166,8 +183,8 @@ func TestGetChaosRunnerENV(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			actualResult := getChaosRunnerENV(mock.instance, mock.aExList, fakeClientUUID)
 			println(actualResult)
-			if len(actualResult) != 11 {
-				t.Fatalf("Test %q failed: expected array length to be 11", name)
+			if len(actualResult) != 12 {
+				t.Fatalf("Test %q failed: expected array length to be 12", name)
 			}
 			for index, result := range actualResult {
 				if result.Value != mock.expectedResult[index].Value {
@@ -1536,6 +1553,92 @@ func TestReconcileForDelete(t *testing.T) {
 	}
 }
 
+func TestReconcileForStopping(t *testing.T) {
+	tests := map[string]struct {
+		engine       chaosTypes.EngineInfo
+		pod          *corev1.Pod
+		engineStatus v1alpha1.EngineStatus
+		requeue      bool
+	}{
+		"Test Positive-1": {
+			engine: chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "engine-stopping-p1",
+						Namespace:  "default",
+						UID:        "engine-stopping-p1-uid",
+						Finalizers: []string{finalizer},
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						EngineState: v1alpha1.EngineStateStop,
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						EngineStatus: v1alpha1.EngineStatusInitialized,
+					},
+				},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "engine-stopping-p1-runner",
+					Namespace: "default",
+					Labels:    map[string]string{"chaosUID": "engine-stopping-p1-uid"},
+				},
+			},
+			engineStatus: v1alpha1.EngineStatusStopping,
+			requeue:      true,
+		},
+		"Test Positive-2": {
+			engine: chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "engine-stopping-p2",
+						Namespace:  "default",
+						UID:        "engine-stopping-p2-uid",
+						Finalizers: []string{finalizer},
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						EngineState: v1alpha1.EngineStateStop,
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						EngineStatus: v1alpha1.EngineStatusStopping,
+					},
+				},
+			},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "engine-stopping-p2-runner",
+					Namespace: "default",
+					Labels:    map[string]string{"chaosUID": "engine-stopping-p2-uid"},
+				},
+			},
+			engineStatus: v1alpha1.EngineStatusStopped,
+			requeue:      false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: mock.engine.Instance.Name, Namespace: mock.engine.Instance.Namespace}}
+			if err := r.client.Create(context.TODO(), mock.engine.Instance); err != nil {
+				t.Fatalf("Test %q failed: unable to create engine: %v", name, err)
+			}
+			if err := r.client.Create(context.TODO(), mock.pod); err != nil {
+				t.Fatalf("Test %q failed: unable to create pod: %v", name, err)
+			}
+			result, err := r.reconcileForDelete(&mock.engine, request)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if mock.requeue != (result.RequeueAfter != 0) {
+				t.Fatalf("Test %q failed: expected requeue to be %v", name, mock.requeue)
+			}
+			if mock.engine.Instance.Status.EngineStatus != mock.engineStatus {
+				t.Fatalf("Test %q failed: expected engine status %v, got %v", name, mock.engineStatus, mock.engine.Instance.Status.EngineStatus)
+			}
+		})
+	}
+}
+
 func TestForceRemoveAllChaosPods(t *testing.T) {
 	tests := map[string]struct {
 		isErr   bool
@@ -1814,18 +1917,191 @@ func TestReconcileForCreationAndRunning(t *testing.T) {
 	for name, mock := range tests {
 		t.Run(name, func(t *testing.T) {
 			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), mock.engine.Instance); err != nil {
+				fmt.Printf("Unable to create engine: %v", err)
+			}
 			reqLogger := chaosTypes.Log.WithValues()
 			_, err := r.reconcileForCreationAndRunning(&mock.engine, reqLogger)
 			if mock.isErr && err == nil {
 				t.Fatalf("Test %q failed: expected error not to be nil", name)
 			}
 			if !mock.isErr && err != nil {
-				t.Fatalf("Test %q failed: expected error to be nil", name)
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+		})
+	}
+}
+
+func TestGetEngineVerdict(t *testing.T) {
+	tests := map[string]struct {
+		instance *v1alpha1.ChaosEngine
+		verdict  v1alpha1.ResultVerdict
+	}{
+		"Test Positive-1": {
+			instance: &v1alpha1.ChaosEngine{
+				Spec: v1alpha1.ChaosEngineSpec{
+					Experiments: []v1alpha1.ExperimentList{{Name: "exp-1"}, {Name: "exp-2"}},
+				},
+				Status: v1alpha1.ChaosEngineStatus{
+					Experiments: []v1alpha1.ExperimentStatuses{
+						{Name: "exp-1", Verdict: "Pass"},
+						{Name: "exp-2", Verdict: "Pass"},
+					},
+				},
+			},
+			verdict: v1alpha1.ResultVerdictPassed,
+		},
+		"Test Positive-2": {
+			instance: &v1alpha1.ChaosEngine{
+				Spec: v1alpha1.ChaosEngineSpec{
+					Experiments: []v1alpha1.ExperimentList{{Name: "exp-1"}, {Name: "exp-2"}},
+				},
+				Status: v1alpha1.ChaosEngineStatus{
+					Experiments: []v1alpha1.ExperimentStatuses{
+						{Name: "exp-1", Verdict: "Pass"},
+					},
+				},
+			},
+			verdict: v1alpha1.ResultVerdictAwaited,
+		},
+		"Test Positive-3": {
+			instance: &v1alpha1.ChaosEngine{
+				Spec: v1alpha1.ChaosEngineSpec{
+					Experiments: []v1alpha1.ExperimentList{{Name: "exp-1"}, {Name: "exp-2"}},
+				},
+				Status: v1alpha1.ChaosEngineStatus{
+					Experiments: []v1alpha1.ExperimentStatuses{
+						{Name: "exp-1", Verdict: "Stopped"},
+						{Name: "exp-2", Verdict: "Fail"},
+					},
+				},
+			},
+			verdict: v1alpha1.ResultVerdictFailed,
+		},
+		"Test Positive-4": {
+			instance: &v1alpha1.ChaosEngine{},
+			verdict:  "",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			if verdict := getEngineVerdict(mock.instance); verdict != mock.verdict {
+				t.Fatalf("Test %q failed: expected verdict %v, got %v", name, mock.verdict, verdict)
+			}
+		})
+	}
+}
+
+func TestUpdateEngineVerdict(t *testing.T) {
+	tests := map[string]struct {
+		engine  chaosTypes.EngineInfo
+		results []v1alpha1.ChaosResult
+		verdict v1alpha1.ResultVerdict
+	}{
+		"Test Positive-1": {
+			engine: chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "engine-verdict-p1",
+						Namespace: "default",
+						UID:       "engine-verdict-p1-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						Experiments: []v1alpha1.ExperimentList{{Name: "exp-1"}},
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						Experiments: []v1alpha1.ExperimentStatuses{{Name: "exp-1", Verdict: "Awaited"}},
+					},
+				},
+			},
+			results: []v1alpha1.ChaosResult{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "engine-verdict-p1-exp-1",
+						Namespace: "default",
+						Labels:    map[string]string{"chaosUID": "engine-verdict-p1-uid"},
+					},
+					Spec: v1alpha1.ChaosResultSpec{ExperimentName: "exp-1"},
+					Status: v1alpha1.ChaosResultStatus{
+						ExperimentStatus: v1alpha1.TestStatus{
+							Verdict:                v1alpha1.ResultVerdictFailed,
+							FailStep:               "Probe failed",
+							ProbeSuccessPercentage: "50",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-engine-exp-1",
+						Namespace: "default",
+						Labels:    map[string]string{"chaosUID": "other-uid"},
+					},
+					Spec: v1alpha1.ChaosResultSpec{ExperimentName: "exp-1"},
+					Status: v1alpha1.ChaosResultStatus{
+						ExperimentStatus: v1alpha1.TestStatus{Verdict: v1alpha1.ResultVerdictPassed},
+					},
+				},
+			},
+			verdict: v1alpha1.ResultVerdictFailed,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), mock.engine.Instance); err != nil {
+				t.Fatalf("Test %q failed: unable to create engine: %v", name, err)
+			}
+			for i := range mock.results {
+				if err := r.client.Create(context.TODO(), &mock.results[i]); err != nil {
+					t.Fatalf("Test %q failed: unable to create chaosresult: %v", name, err)
+				}
+			}
+			if err := r.updateEngineVerdict(&mock.engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got %v", name, err)
+			}
+			if mock.engine.Instance.Status.Verdict != mock.verdict {
+				t.Fatalf("Test %q failed: expected verdict %v, got %v", name, mock.verdict, mock.engine.Instance.Status.Verdict)
+			}
+			exp := mock.engine.Instance.Status.Experiments[0]
+			if exp.FailStep != "Probe failed" || exp.ProbeSuccessPercentage != "50" {
+				t.Fatalf("Test %q failed: chaosresult details are not rolled up into the engine", name)
 			}
 		})
 	}
 }
 
+type fakeReconciler struct {
+	err error
+}
+
+func (f *fakeReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	return reconcile.Result{}, f.err
+}
+
+func TestRateLimitedReconciler(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "engine", Namespace: "default"}}
+	reconciler := &fakeReconciler{err: fmt.Errorf("fake error")}
+	r := newRateLimitedReconciler(reconciler)
+
+	first, err := r.Reconcile(request)
+	if err != nil {
+		t.Fatalf("expected error to be nil, got %v", err)
+	}
+	second, _ := r.Reconcile(request)
+	if first.RequeueAfter == 0 || second.RequeueAfter <= first.RequeueAfter {
+		t.Fatalf("expected exponential requeue delays, got %v and %v", first.RequeueAfter, second.RequeueAfter)
+	}
+
+	reconciler.err = nil
+	if result, _ := r.Reconcile(request); result.RequeueAfter != 0 {
+		t.Fatalf("expected no requeue after a successful reconcile, got %v", result.RequeueAfter)
+	}
+	reconciler.err = fmt.Errorf("fake error")
+	if result, _ := r.Reconcile(request); result.RequeueAfter != first.RequeueAfter {
+		t.Fatalf("expected the delay to be reset after a successful reconcile, got %v", result.RequeueAfter)
+	}
+}
+
 func CreateFakeClient(t *testing.T) *ReconcileChaosEngine {
 
 	fakeClient := litmusFakeClientset.NewFakeClient()
@@ -1846,15 +2122,2042 @@ func CreateFakeClient(t *testing.T) *ReconcileChaosEngine {
 		Items: []v1alpha1.ChaosResult{},
 	}
 
-	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, engineR, chaosResultList)
+	s.AddKnownTypes(v1alpha1.SchemeGroupVersion, engineR, &v1alpha1.ChaosEngineList{}, &v1alpha1.ChaosResult{}, chaosResultList, &v1alpha1.ChaosExperiment{}, &v1alpha1.ChaosExperimentList{})
 
 	recorder := record.NewFakeRecorder(1024)
 
+	clientSet := fake.NewSimpleClientset()
+
 	r := &ReconcileChaosEngine{
-		client:   fakeClient,
-		scheme:   s,
-		recorder: recorder,
+		client:          fakeClient,
+		apiReader:       fakeClient,
+		scheme:          s,
+		recorder:        recorder,
+		clientSet:       clientSet,
+		dynamicClient:   dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		discoveryClient: memory.NewMemCacheClient(clientSet.Discovery()),
 	}
 
 	return r
 }
+
+func TestGetRunnerBackend(t *testing.T) {
+	tests := map[string]struct {
+		runnerType v1alpha1.RunnerType
+		expected   runnerBackend
+		isErr      bool
+	}{
+		"Test Positive-1": {
+			runnerType: "",
+			expected:   &podRunner{},
+		},
+		"Test Positive-2": {
+			runnerType: v1alpha1.RunnerTypeGo,
+			expected:   &podRunner{},
+		},
+		"Test Positive-3": {
+			runnerType: v1alpha1.RunnerTypeJob,
+			expected:   &jobRunner{},
+		},
+		"Test Positive-4": {
+			runnerType: v1alpha1.RunnerTypeSimulated,
+			expected:   &simulatedRunner{},
+		},
+		"Test Negative-1": {
+			runnerType: "ansible",
+			isErr:      true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					Spec: v1alpha1.ChaosEngineSpec{
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Type: mock.runnerType,
+							},
+						},
+					},
+				},
+			}
+			runner, err := getRunnerBackend(engine)
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if fmt.Sprintf("%T", runner) != fmt.Sprintf("%T", mock.expected) {
+				t.Fatalf("Test %q failed: expected runner %T, got %T", name, mock.expected, runner)
+			}
+		})
+	}
+}
+
+func TestJobRunner(t *testing.T) {
+	var backoffLimit int32 = 2
+	tests := map[string]struct {
+		jobStatus   batchv1.JobStatus
+		isCompleted bool
+	}{
+		"Test Positive-1": {
+			jobStatus:   batchv1.JobStatus{Succeeded: 1},
+			isCompleted: true,
+		},
+		"Test Positive-2": {
+			jobStatus: batchv1.JobStatus{
+				Failed: 3,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+				},
+			},
+			isCompleted: true,
+		},
+		"Test Negative-1": {
+			jobStatus:   batchv1.JobStatus{Active: 1},
+			isCompleted: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-runner-job",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Type:         v1alpha1.RunnerTypeJob,
+								Image:        "fake-runner-image",
+								BackoffLimit: &backoffLimit,
+							},
+						},
+					},
+				},
+				AppExperiments: []string{"exp-1"},
+			}
+			r := CreateFakeClient(t)
+			runner := &jobRunner{}
+			if err := runner.launch(r, &engine, startReqLogger(reconcile.Request{})); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+
+			runnerJob := &batchv1.Job{}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "test-runner-job-runner", Namespace: "test"}, runnerJob); err != nil {
+				t.Fatalf("Test %q failed: unable to get runner job, err: %v", name, err)
+			}
+			if runnerJob.Spec.BackoffLimit == nil || *runnerJob.Spec.BackoffLimit != backoffLimit {
+				t.Fatalf("Test %q failed: expected backoffLimit %v, got %v", name, backoffLimit, runnerJob.Spec.BackoffLimit)
+			}
+
+			runnerJob.Status = mock.jobStatus
+			if err := r.client.Update(context.TODO(), runnerJob); err != nil {
+				t.Fatalf("Test %q failed: unable to update runner job, err: %v", name, err)
+			}
+			if isCompleted := runner.isCompleted(r, &engine); isCompleted != mock.isCompleted {
+				t.Fatalf("Test %q failed: expected isCompleted %v, got %v", name, mock.isCompleted, isCompleted)
+			}
+		})
+	}
+}
+
+func TestRunnerlessRunner(t *testing.T) {
+	engine := chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runnerless",
+				Namespace: "test",
+				UID:       "fake-uid",
+			},
+			Spec: v1alpha1.ChaosEngineSpec{
+				ChaosServiceAccount: "fake-serviceAccount",
+				Appinfo: v1alpha1.ApplicationParams{
+					Applabel: "app=nginx",
+					AppKind:  "deployment",
+				},
+				Components: v1alpha1.ComponentParams{
+					Runner: v1alpha1.RunnerInfo{
+						Type: v1alpha1.RunnerTypeRunnerless,
+					},
+				},
+				Experiments: []v1alpha1.ExperimentList{
+					{
+						Name: "exp-1",
+						Spec: v1alpha1.ExperimentAttributes{
+							Components: v1alpha1.ExperimentComponents{
+								ENV: []corev1.EnvVar{{Name: "TOTAL_CHAOS_DURATION", Value: "60"}},
+							},
+						},
+					},
+					{
+						Name: "exp-2",
+					},
+				},
+			},
+			Status: v1alpha1.ChaosEngineStatus{
+				EngineStatus: v1alpha1.EngineStatusInitialized,
+			},
+		},
+		AppExperiments: []string{"exp-1", "exp-2"},
+	}
+	experiment := &v1alpha1.ChaosExperiment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "exp-1",
+			Namespace: "test",
+		},
+		Spec: v1alpha1.ChaosExperimentSpec{
+			Definition: v1alpha1.ExperimentDef{
+				Image:   "fake-experiment-image",
+				Command: []string{"/bin/bash"},
+				Args:    []string{"-c", "./experiments -name exp-1"},
+				ENVList: []corev1.EnvVar{
+					{Name: "TOTAL_CHAOS_DURATION", Value: "30"},
+					{Name: "CHAOS_INTERVAL", Value: "10"},
+				},
+				HostPID: true,
+				HostFileVolumes: []v1alpha1.HostFile{
+					{Name: "socket", MountPath: "/var/run/docker.sock", NodePath: "/var/run/docker.sock"},
+				},
+			},
+		},
+	}
+
+	r := CreateFakeClient(t)
+	runner := &runnerlessRunner{}
+	reqLogger := startReqLogger(reconcile.Request{})
+	if err := r.client.Create(context.TODO(), engine.Instance); err != nil {
+		t.Fatalf("Unable to create engine: %v", err)
+	}
+	if err := r.client.Create(context.TODO(), experiment); err != nil {
+		t.Fatalf("Unable to create experiment: %v", err)
+	}
+
+	if err := runner.launch(r, &engine, reqLogger); err != nil {
+		t.Fatalf("Unable to launch the experiments: %v", err)
+	}
+	job := &batchv1.Job{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "test-runnerless-exp-1", Namespace: "test"}, job); err != nil {
+		t.Fatalf("Unable to get the experiment job: %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+	if !podSpec.HostPID || len(podSpec.Volumes) != 1 || podSpec.Volumes[0].HostPath == nil {
+		t.Fatalf("Experiment job doesn't contain the hostPID and hostFileVolumes of the definition")
+	}
+	env := map[string]string{}
+	for _, e := range podSpec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env["TOTAL_CHAOS_DURATION"] != "60" || env["CHAOS_INTERVAL"] != "10" || env["CHAOS_UID"] != "fake-uid" || env["APP_LABEL"] != "app=nginx" {
+		t.Fatalf("Experiment job doesn't contain the merged ENVs, got: %v", env)
+	}
+	if getExperimentStatus(engine.Instance, "exp-1") != v1alpha1.ExperimentStatusRunning || runner.isCompleted(r, &engine) {
+		t.Fatalf("Expected exp-1 to be running, got: %v", engine.Instance.Status.Experiments)
+	}
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := r.client.Update(context.TODO(), job); err != nil {
+		t.Fatalf("Unable to update the experiment job: %v", err)
+	}
+	if err := runner.launch(r, &engine, reqLogger); err != nil {
+		t.Fatalf("Unable to launch the experiments: %v", err)
+	}
+	if getExperimentStatus(engine.Instance, "exp-1") != v1alpha1.ExperimentStatusCompleted {
+		t.Fatalf("Expected exp-1 to be completed, got: %v", engine.Instance.Status.Experiments)
+	}
+	if getExperimentStatus(engine.Instance, "exp-2") != v1alpha1.ExperimentStatusNotFound {
+		t.Fatalf("Expected exp-2 to be not found, got: %v", engine.Instance.Status.Experiments)
+	}
+	if !runner.isCompleted(r, &engine) {
+		t.Fatalf("Expected the experiments to be completed")
+	}
+}
+
+func TestCreateInlineConfigMaps(t *testing.T) {
+	tests := map[string]struct {
+		runnerConfigMaps     []v1alpha1.ConfigMap
+		experimentConfigMaps []v1alpha1.ConfigMap
+		existing             *corev1.ConfigMap
+		created              []string
+		isErr                bool
+	}{
+		"Test Positive-1": {
+			runnerConfigMaps: []v1alpha1.ConfigMap{
+				{Name: "runner-config", MountPath: "/mnt", Data: map[string]string{"key": "value"}},
+				{Name: "existing-config", MountPath: "/tmp"},
+			},
+			experimentConfigMaps: []v1alpha1.ConfigMap{
+				{Name: "experiment-config", MountPath: "/mnt", Data: map[string]string{"parameters.yml": "a: b"}},
+				{Name: "runner-config", MountPath: "/mnt", Data: map[string]string{"key": "value"}},
+			},
+			created: []string{"runner-config", "experiment-config"},
+			isErr:   false,
+		},
+		"Test Negative-1": {
+			runnerConfigMaps: []v1alpha1.ConfigMap{
+				{Name: "runner-config", MountPath: "/mnt", Data: map[string]string{"key": "value"}},
+			},
+			experimentConfigMaps: []v1alpha1.ConfigMap{
+				{Name: "runner-config", MountPath: "/mnt", Data: map[string]string{"key": "other-value"}},
+			},
+			isErr: true,
+		},
+		"Test Negative-2": {
+			runnerConfigMaps: []v1alpha1.ConfigMap{
+				{Name: "user-config", MountPath: "/mnt", Data: map[string]string{"key": "value"}},
+			},
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user-config",
+					Namespace: "test",
+				},
+			},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-configmaps",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								ConfigMaps: mock.runnerConfigMaps,
+							},
+						},
+						Experiments: []v1alpha1.ExperimentList{
+							{
+								Name: "exp-1",
+								Spec: v1alpha1.ExperimentAttributes{
+									Components: v1alpha1.ExperimentComponents{
+										ConfigMaps: mock.experimentConfigMaps,
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			r := CreateFakeClient(t)
+			if mock.existing != nil {
+				if err := r.client.Create(context.TODO(), mock.existing); err != nil {
+					t.Fatalf("Unable to create configmap: %v", err)
+				}
+			}
+
+			err := r.createInlineConfigMaps(engine)
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			for _, configMapName := range mock.created {
+				configMap := &corev1.ConfigMap{}
+				if err := r.client.Get(context.TODO(), types.NamespacedName{Name: configMapName, Namespace: "test"}, configMap); err != nil {
+					t.Fatalf("Test %q failed: unable to get configmap %s, err: %v", name, configMapName, err)
+				}
+				if !metav1.IsControlledBy(configMap, engine.Instance) {
+					t.Fatalf("Test %q failed: configmap %s is not owned by the chaosengine", name, configMapName)
+				}
+			}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "existing-config", Namespace: "test"}, &corev1.ConfigMap{}); err == nil {
+				t.Fatalf("Test %q failed: configmap without inline data should not be created", name)
+			}
+		})
+	}
+}
+
+func TestRemoveInlineConfigMaps(t *testing.T) {
+	engine := &chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-configmaps",
+				Namespace: "test",
+				UID:       "fake-uid",
+			},
+		},
+	}
+	tests := map[string]struct {
+		labels    map[string]string
+		isRemoved bool
+	}{
+		"Test Positive-1": {
+			labels:    map[string]string{"chaosUID": "fake-uid", "app.kubernetes.io/component": inlineConfigMapComponent},
+			isRemoved: true,
+		},
+		"Test Negative-1": {
+			labels:    map[string]string{"chaosUID": "other-uid", "app.kubernetes.io/component": inlineConfigMapComponent},
+			isRemoved: false,
+		},
+		"Test Negative-2": {
+			labels:    map[string]string{"chaosUID": "fake-uid", "app.kubernetes.io/component": logArchiveComponent},
+			isRemoved: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := CreateFakeClient(t)
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "runner-config",
+					Namespace: "test",
+					Labels:    mock.labels,
+				},
+			}
+			if err := r.client.Create(context.TODO(), configMap); err != nil {
+				t.Fatalf("Unable to create configmap: %v", err)
+			}
+
+			if err := r.removeInlineConfigMaps(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			err := r.client.Get(context.TODO(), types.NamespacedName{Name: "runner-config", Namespace: "test"}, &corev1.ConfigMap{})
+			if mock.isRemoved != k8serrors.IsNotFound(err) {
+				t.Fatalf("Test %q failed: expected configmap to be removed %v, err: %v", name, mock.isRemoved, err)
+			}
+		})
+	}
+}
+
+func TestNewGoRunnerPodForCRTolerations(t *testing.T) {
+	toleration := corev1.Toleration{Key: "chaos", Operator: corev1.TolerationOpExists}
+	tests := map[string]struct {
+		engineTolerations  []corev1.Toleration
+		defaultTolerations []corev1.Toleration
+		expected           []corev1.Toleration
+	}{
+		"Test Positive-1": {
+			engineTolerations:  []corev1.Toleration{toleration},
+			defaultTolerations: []corev1.Toleration{},
+			expected:           []corev1.Toleration{toleration},
+		},
+		"Test Positive-2": {
+			engineTolerations:  []corev1.Toleration{},
+			defaultTolerations: []corev1.Toleration{toleration},
+			expected:           []corev1.Toleration{toleration},
+		},
+		"Test Positive-3": {
+			engineTolerations:  nil,
+			defaultTolerations: []corev1.Toleration{},
+			expected:           nil,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			previousConfig := config.Get()
+			defer config.Set(previousConfig)
+			operatorConfig := config.Get()
+			operatorConfig.Runner.Tolerations = mock.defaultTolerations
+			config.Set(operatorConfig)
+
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-tolerations",
+						Namespace: "test",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Image:       "fake-runner-image",
+								Tolerations: mock.engineTolerations,
+							},
+						},
+					},
+				},
+				AppExperiments: []string{"exp-1"},
+			}
+
+			runnerPod, err := newGoRunnerPodForCR(engine)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if !reflect.DeepEqual(runnerPod.Spec.Tolerations, mock.expected) {
+				t.Fatalf("Test %q failed: expected tolerations %v, got: %v", name, mock.expected, runnerPod.Spec.Tolerations)
+			}
+		})
+	}
+}
+
+func TestNewGoRunnerPodForCRVolumes(t *testing.T) {
+	var defaultMode int32 = 0400
+	var expirationSeconds int64 = 3600
+	engine := &chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-volumes",
+				Namespace: "test",
+			},
+			Spec: v1alpha1.ChaosEngineSpec{
+				ChaosServiceAccount: "fake-serviceAccount",
+				Components: v1alpha1.ComponentParams{
+					Runner: v1alpha1.RunnerInfo{
+						Image: "fake-runner-image",
+						ConfigMaps: []v1alpha1.ConfigMap{
+							{Name: "plain-config", MountPath: "/mnt/plain", SubPath: "config.yaml", ReadOnly: true},
+							{Name: "items-config", MountPath: "/mnt/items", Items: []corev1.KeyToPath{{Key: "a", Path: "b"}}, DefaultMode: &defaultMode},
+						},
+						VolumeSources: v1alpha1.VolumeSources{
+							EmptyDirs:              []v1alpha1.EmptyDir{{Name: "scratch", MountPath: "/tmp/scratch", Medium: corev1.StorageMediumMemory}},
+							PersistentVolumeClaims: []v1alpha1.PersistentVolumeClaim{{Name: "logs", ClaimName: "chaos-logs", MountPath: "/logs", ReadOnly: true}},
+							HostFileVolumes:        []v1alpha1.HostFile{{Name: "socket", MountPath: "/var/run/docker.sock", NodePath: "/var/run/docker.sock", Type: corev1.HostPathSocket}},
+							ProjectedServiceAccountTokens: []v1alpha1.ProjectedServiceAccountToken{
+								{Name: "token", MountPath: "/var/run/secrets/tokens", Path: "token", Audience: "vault", ExpirationSeconds: &expirationSeconds},
+							},
+						},
+					},
+				},
+			},
+		},
+		AppExperiments: []string{"exp-1"},
+	}
+
+	runnerPod, err := newGoRunnerPodForCR(engine)
+	if err != nil {
+		t.Fatalf("Unable to build the runner pod: %v", err)
+	}
+
+	volumes := map[string]corev1.Volume{}
+	for _, v := range runnerPod.Spec.Volumes {
+		volumes[v.Name] = v
+	}
+	if len(volumes) != 6 {
+		t.Fatalf("Expected 6 volumes, got: %v", runnerPod.Spec.Volumes)
+	}
+	if cm := volumes["items-config"].ConfigMap; cm == nil || len(cm.Items) != 1 || *cm.DefaultMode != defaultMode {
+		t.Fatalf("Expected items and defaultMode on the configmap volume, got: %v", volumes["items-config"])
+	}
+	if volumes["scratch"].EmptyDir == nil || volumes["scratch"].EmptyDir.Medium != corev1.StorageMediumMemory {
+		t.Fatalf("Expected memory backed emptyDir volume, got: %v", volumes["scratch"])
+	}
+	if volumes["logs"].PersistentVolumeClaim == nil || volumes["logs"].PersistentVolumeClaim.ClaimName != "chaos-logs" {
+		t.Fatalf("Expected pvc volume, got: %v", volumes["logs"])
+	}
+	if volumes["socket"].HostPath == nil || *volumes["socket"].HostPath.Type != corev1.HostPathSocket {
+		t.Fatalf("Expected hostPath volume of socket type, got: %v", volumes["socket"])
+	}
+	if projected := volumes["token"].Projected; projected == nil || projected.Sources[0].ServiceAccountToken.Audience != "vault" {
+		t.Fatalf("Expected projected service account token volume, got: %v", volumes["token"])
+	}
+
+	mounts := map[string]corev1.VolumeMount{}
+	for _, m := range runnerPod.Spec.Containers[0].VolumeMounts {
+		mounts[m.Name] = m
+	}
+	if mounts["plain-config"].SubPath != "config.yaml" || !mounts["plain-config"].ReadOnly || !mounts["logs"].ReadOnly {
+		t.Fatalf("Expected subPath and readOnly on the volume mounts, got: %v", mounts)
+	}
+}
+
+func TestApplyRunnerPodTemplate(t *testing.T) {
+	tests := map[string]struct {
+		podTemplate string
+		env         []corev1.EnvVar
+		isErr       bool
+	}{
+		"Test Positive-1": {
+			podTemplate: `{"metadata":{"labels":{"team":"sre"}},"spec":{"priorityClassName":"high","hostAliases":[{"ip":"10.0.0.1","hostnames":["chaos.local"]}],"containers":[{"name":"chaos-runner","env":[{"name":"EXTRA","value":"true"}]}]}}`,
+			isErr:       false,
+		},
+		"Test Positive-2": {
+			podTemplate: `{"metadata":{"labels":{"team":"sre"}},"spec":{"priorityClassName":"high","hostAliases":[{"ip":"10.0.0.1","hostnames":["chaos.local"]}]}}`,
+			env: []corev1.EnvVar{{
+				Name: "PROXY_PASSWORD",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "proxy"},
+						Key:                  "password",
+					},
+				},
+			}},
+			isErr: false,
+		},
+		"Test Negative-1": {
+			podTemplate: `{"spec":{"serviceAccountName":"admin"}}`,
+			isErr:       true,
+		},
+		"Test Negative-2": {
+			podTemplate: `{"metadata":{"labels":{"chaosUID":"other-uid"}}}`,
+			isErr:       true,
+		},
+		"Test Negative-3": {
+			podTemplate: `{"spec":{"containers":[{"name":"chaos-runner","env":[{"name":"CHAOSENGINE","value":"other-engine"}]}]}}`,
+			isErr:       true,
+		},		"Test Negative-4": {
+			podTemplate: `{"metadata":{"ownerReferences":[{"apiVersion":"apps/v1","kind":"Deployment","name":"other","uid":"other-uid","controller":true}]}}`,
+			isErr:       true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-template",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Image:       "fake-runner-image",
+								PodTemplate: &runtime.RawExtension{Raw: []byte(mock.podTemplate)},
+								ENV:         mock.env,
+							},
+						},
+					},
+				},
+				AppExperiments: []string{"exp-1"},
+			}
+			runnerPod, err := newGoRunnerPodForCR(engine)
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if mock.isErr {
+				return
+			}
+			if runnerPod.Spec.PriorityClassName != "high" || len(runnerPod.Spec.HostAliases) != 1 || runnerPod.Labels["team"] != "sre" {
+				t.Fatalf("Test %q failed: podTemplate is not applied on the runner pod: %v", name, runnerPod)
+			}
+			if len(runnerPod.Spec.Containers) != 1 || len(runnerPod.Spec.Containers[0].Env) != 8 || runnerPod.Spec.Containers[0].Image != "fake-runner-image" {
+				t.Fatalf("Test %q failed: expected the runner container to be merged, got: %v", name, runnerPod.Spec.Containers)
+			}
+		})
+	}
+}
+
+func TestGetRunnerENV(t *testing.T) {
+	tests := map[string]struct {
+		env   []corev1.EnvVar
+		isErr bool
+	}{
+		"Test Positive-1": {
+			env: []corev1.EnvVar{
+				{Name: "HTTP_PROXY", Value: "http://proxy:3128"},
+				{Name: "LOG_LEVEL", Value: "debug"},
+			},
+			isErr: false,
+		},
+		"Test Negative-1": {
+			env: []corev1.EnvVar{
+				{Name: "CHAOSENGINE", Value: "other-engine"},
+			},
+			isErr: true,
+		},
+		"Test Negative-2": {
+			env: []corev1.EnvVar{
+				{Name: "LOG_LEVEL", Value: "debug"},
+				{Name: "LOG_LEVEL", Value: "info"},
+			},
+			isErr: true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-env",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Image: "fake-runner-image",
+								ENV:   mock.env,
+								EnvFrom: []corev1.EnvFromSource{
+									{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "runner-env"}}},
+								},
+							},
+						},
+					},
+				},
+				AppExperiments: []string{"exp-1"},
+			}
+			runnerPod, err := newGoRunnerPodForCR(engine)
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if mock.isErr {
+				return
+			}
+			runnerContainer := runnerPod.Spec.Containers[0]
+			if len(runnerContainer.Env) != 7+len(mock.env) || len(runnerContainer.EnvFrom) != 1 {
+				t.Fatalf("Test %q failed: expected the runner ENVs to be merged, got: %v", name, runnerContainer)
+			}
+		})
+	}
+}
+
+func TestNewGoRunnerPodForCRRunID(t *testing.T) {
+	tests := map[string]struct {
+		runID        string
+		expectedName string
+	}{
+		"Test Positive-1": {
+			runID:        "abc123",
+			expectedName: "test-runid-runner-abc123",
+		},
+		"Test Positive-2": {
+			runID:        "",
+			expectedName: "test-runid-runner",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-runid",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						Components: v1alpha1.ComponentParams{
+							Runner: v1alpha1.RunnerInfo{
+								Image: "fake-runner-image",
+							},
+						},
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						RunID: mock.runID,
+					},
+				},
+				AppExperiments: []string{"exp-1"},
+			}
+			runnerPod, err := newGoRunnerPodForCR(engine)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if runnerPod.Name != mock.expectedName {
+				t.Fatalf("Test %q failed: expected runner pod name %v, got: %v", name, mock.expectedName, runnerPod.Name)
+			}
+			if runnerPod.Labels["chaosRunID"] != mock.runID {
+				t.Fatalf("Test %q failed: expected chaosRunID label %q, got: %q", name, mock.runID, runnerPod.Labels["chaosRunID"])
+			}
+			hasRunID := false
+			for _, env := range runnerPod.Spec.Containers[0].Env {
+				if env.Name == "RUN_ID" {
+					hasRunID = env.Value == mock.runID
+				}
+			}
+			if hasRunID != (mock.runID != "") {
+				t.Fatalf("Test %q failed: expected RUN_ID env %q, got: %v", name, mock.runID, runnerPod.Spec.Containers[0].Env)
+			}
+		})
+	}
+}
+
+func TestPersistChaosLogs(t *testing.T) {
+	tests := map[string]struct {
+		logArchive *v1alpha1.LogArchive
+		location   string
+		isErr      bool
+	}{
+		"Test Positive-1": {
+			logArchive: &v1alpha1.LogArchive{Type: v1alpha1.LogArchiveTypeConfigMap},
+			location:   "configmap/test-archive-logs-abc123",
+			isErr:      false,
+		},
+		"Test Positive-2": {
+			logArchive: &v1alpha1.LogArchive{Type: v1alpha1.LogArchiveTypePVC, ClaimName: "chaos-logs"},
+			location:   "",
+			isErr:      false,
+		},
+		"Test Negative-1": {
+			logArchive: &v1alpha1.LogArchive{Type: v1alpha1.LogArchiveTypePVC},
+			isErr:      true,
+		},
+		"Test Negative-2": {
+			logArchive: &v1alpha1.LogArchive{Type: "s3"},
+			isErr:      true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-archive",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						LogArchive:          mock.logArchive,
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						RunID: "abc123",
+					},
+				},
+			}
+			result := &v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-archive-exp-1",
+					Namespace: "test",
+					Labels:    map[string]string{"chaosUID": "fake-uid"},
+				},
+			}
+			logs := map[string]string{"test-archive-runner-abc123.chaos-runner.log": "fake logs"}
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), result); err != nil {
+				t.Fatalf("Unable to create chaosresult: %v", err)
+			}
+
+			err := validateLogArchive(mock.logArchive)
+			if err == nil {
+				err = r.persistChaosLogs(engine, logs)
+			}
+			if mock.isErr && err == nil {
+				t.Fatalf("Test %q failed: expected error not to be nil", name)
+			}
+			if !mock.isErr && err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if mock.isErr {
+				return
+			}
+
+			configMap := &corev1.ConfigMap{}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "test-archive-logs-abc123", Namespace: "test"}, configMap); err != nil {
+				t.Fatalf("Test %q failed: unable to get the log archive configmap, err: %v", name, err)
+			}
+			if _, ok := configMap.Data["test-archive-runner-abc123.chaos-runner.log"]; !ok {
+				t.Fatalf("Test %q failed: expected the runner logs to be archived, got: %v", name, configMap.Data)
+			}
+			if len(configMap.OwnerReferences) != 0 {
+				t.Fatalf("Test %q failed: expected the log archive not to be owned, got: %v", name, configMap.OwnerReferences)
+			}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: result.Name, Namespace: result.Namespace}, result); err != nil {
+				t.Fatalf("Test %q failed: unable to get chaosresult, err: %v", name, err)
+			}
+			if result.Annotations["litmuschaos.io/log-archive"] != mock.location {
+				t.Fatalf("Test %q failed: expected log archive location %v, got: %v", name, mock.location, result.Annotations)
+			}
+			archiverPod := &corev1.Pod{}
+			err = r.client.Get(context.TODO(), types.NamespacedName{Name: "test-archive-logs-abc123", Namespace: "test"}, archiverPod)
+			if (mock.logArchive.Type == v1alpha1.LogArchiveTypePVC) != (err == nil) {
+				t.Fatalf("Test %q failed: expected the archiver pod only for the pvc type, err: %v", name, err)
+			}
+		})
+	}
+}
+
+func TestAnnotateChaosResultsWithLogArchive(t *testing.T) {
+	tests := map[string]struct {
+		previousLocation string
+		componentLabel   string
+		isRemoved        bool
+	}{
+		"Test Positive-1": {
+			previousLocation: "configmap/test-archive-logs-old123",
+			componentLabel:   logArchiveComponent,
+			isRemoved:        true,
+		},
+		"Test Negative-1": {
+			previousLocation: "configmap/test-archive-logs-old123",
+			componentLabel:   inlineConfigMapComponent,
+			isRemoved:        false,
+		},
+		"Test Negative-2": {
+			previousLocation: "pvc/chaos-logs/test-archive/old123",
+			componentLabel:   logArchiveComponent,
+			isRemoved:        false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-archive",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+				},
+			}
+			r := CreateFakeClient(t)
+			result := &v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-archive-exp-1",
+					Namespace:   "test",
+					Labels:      map[string]string{"chaosUID": "fake-uid"},
+					Annotations: map[string]string{logArchiveAnnotationKey: mock.previousLocation},
+				},
+			}
+			if err := r.client.Create(context.TODO(), result); err != nil {
+				t.Fatalf("Unable to create chaosresult: %v", err)
+			}
+			previousArchive := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-archive-logs-old123",
+					Namespace: "test",
+					Labels:    map[string]string{"chaosUID": "fake-uid", "app.kubernetes.io/component": mock.componentLabel},
+				},
+			}
+			if err := r.client.Create(context.TODO(), previousArchive); err != nil {
+				t.Fatalf("Unable to create configmap: %v", err)
+			}
+
+			if err := r.annotateChaosResultsWithLogArchive(engine, "configmap/test-archive-logs-abc123"); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			err := r.client.Get(context.TODO(), types.NamespacedName{Name: previousArchive.Name, Namespace: "test"}, &corev1.ConfigMap{})
+			if mock.isRemoved != k8serrors.IsNotFound(err) {
+				t.Fatalf("Test %q failed: expected the previous archive to be removed %v, err: %v", name, mock.isRemoved, err)
+			}
+		})
+	}
+}
+
+func TestReconcileLogArchiver(t *testing.T) {
+	tests := map[string]struct {
+		phase            corev1.PodPhase
+		noArchiver       bool
+		archiving        bool
+		location         string
+		isConfigMapFound bool
+		isPodFound       bool
+	}{
+		"Test Positive-1": {
+			phase:            corev1.PodRunning,
+			archiving:        true,
+			location:         "",
+			isConfigMapFound: true,
+			isPodFound:       true,
+		},
+		"Test Positive-2": {
+			phase:            corev1.PodSucceeded,
+			archiving:        false,
+			location:         "pvc/chaos-logs/test-archive/abc123",
+			isConfigMapFound: false,
+			isPodFound:       false,
+		},
+		"Test Positive-3": {
+			phase:            corev1.PodFailed,
+			archiving:        false,
+			location:         "configmap/test-archive-logs-abc123",
+			isConfigMapFound: true,
+			isPodFound:       false,
+		},
+		"Test Positive-4": {
+			noArchiver:       true,
+			archiving:        false,
+			location:         "",
+			isConfigMapFound: true,
+			isPodFound:       false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-archive",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						LogArchive:          &v1alpha1.LogArchive{Type: v1alpha1.LogArchiveTypePVC, ClaimName: "chaos-logs"},
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						RunID: "abc123",
+					},
+				},
+			}
+			result := &v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-archive-exp-1",
+					Namespace: "test",
+					Labels:    map[string]string{"chaosUID": "fake-uid"},
+				},
+			}
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), result); err != nil {
+				t.Fatalf("Unable to create chaosresult: %v", err)
+			}
+			if err := r.client.Create(context.TODO(), newLogArchiveConfigMapForCR(engine, map[string]string{"runner.log": "fake logs"})); err != nil {
+				t.Fatalf("Unable to create the log archive configmap: %v", err)
+			}
+			if !mock.noArchiver {
+				archiverPod, err := newLogArchiverPodForCR(engine, "test-archive-logs-abc123")
+				if err != nil {
+					t.Fatalf("Unable to build the archiver pod: %v", err)
+				}
+				archiverPod.Status.Phase = mock.phase
+				if err := r.client.Create(context.TODO(), archiverPod); err != nil {
+					t.Fatalf("Unable to create the archiver pod: %v", err)
+				}
+			}
+
+			archiving, err := r.reconcileLogArchiver(engine)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if archiving != mock.archiving {
+				t.Fatalf("Test %q failed: expected archiving %v, got: %v", name, mock.archiving, archiving)
+			}
+			if err := r.client.Get(context.TODO(), types.NamespacedName{Name: result.Name, Namespace: result.Namespace}, result); err != nil {
+				t.Fatalf("Test %q failed: unable to get chaosresult, err: %v", name, err)
+			}
+			if result.Annotations["litmuschaos.io/log-archive"] != mock.location {
+				t.Fatalf("Test %q failed: expected log archive location %v, got: %v", name, mock.location, result.Annotations)
+			}
+			key := types.NamespacedName{Name: "test-archive-logs-abc123", Namespace: "test"}
+			if err := r.client.Get(context.TODO(), key, &corev1.ConfigMap{}); (err == nil) != mock.isConfigMapFound {
+				t.Fatalf("Test %q failed: expected the configmap to be found %v, err: %v", name, mock.isConfigMapFound, err)
+			}
+			if err := r.client.Get(context.TODO(), key, &corev1.Pod{}); (err == nil) != mock.isPodFound {
+				t.Fatalf("Test %q failed: expected the archiver pod to be found %v, err: %v", name, mock.isPodFound, err)
+			}
+		})
+	}
+}
+
+func TestRemoveStaleLogArchivers(t *testing.T) {
+	tests := map[string]struct {
+		runID     string
+		phase     corev1.PodPhase
+		isRemoved bool
+	}{
+		"Test Positive-1": {
+			runID:     "old123",
+			phase:     corev1.PodSucceeded,
+			isRemoved: true,
+		},
+		"Test Positive-2": {
+			runID:     "old123",
+			phase:     corev1.PodFailed,
+			isRemoved: true,
+		},
+		"Test Negative-1": {
+			runID:     "old123",
+			phase:     corev1.PodRunning,
+			isRemoved: false,
+		},
+		"Test Negative-2": {
+			runID:     "abc123",
+			phase:     corev1.PodSucceeded,
+			isRemoved: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-archive",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosServiceAccount: "fake-serviceAccount",
+						LogArchive:          &v1alpha1.LogArchive{Type: v1alpha1.LogArchiveTypePVC, ClaimName: "chaos-logs"},
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						RunID: mock.runID,
+					},
+				},
+			}
+			r := CreateFakeClient(t)
+			archiverPod, err := newLogArchiverPodForCR(engine, getLogArchiveName(engine.Instance))
+			if err != nil {
+				t.Fatalf("Unable to build the archiver pod: %v", err)
+			}
+			if _, ok := archiverPod.Labels["chaosUID"]; ok {
+				t.Fatalf("Test %q failed: expected the archiver pod not to be labeled with the chaosUID, got: %v", name, archiverPod.Labels)
+			}
+			archiverPod.Status.Phase = mock.phase
+			if err := r.client.Create(context.TODO(), archiverPod); err != nil {
+				t.Fatalf("Unable to create the archiver pod: %v", err)
+			}
+
+			engine.Instance.Status.RunID = "abc123"
+			if err := r.removeStaleLogArchivers(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			err = r.client.Get(context.TODO(), types.NamespacedName{Name: archiverPod.Name, Namespace: "test"}, &corev1.Pod{})
+			if mock.isRemoved != k8serrors.IsNotFound(err) {
+				t.Fatalf("Test %q failed: expected the archiver pod to be removed %v, err: %v", name, mock.isRemoved, err)
+			}
+		})
+	}
+}
+
+func TestTruncateChaosLogs(t *testing.T) {
+	tests := map[string]struct {
+		logs     map[string]string
+		maxSize  int
+		expected map[string]string
+	}{
+		"Test Positive-1": {
+			logs:     map[string]string{"runner.log": "fake logs", "experiment.log": "fake logs"},
+			maxSize:  100,
+			expected: map[string]string{"runner.log": "fake logs", "experiment.log": "fake logs"},
+		},
+		"Test Positive-2": {
+			logs:     map[string]string{"runner.log": "short", "experiment.log": strings.Repeat("a", 100) + "tail"},
+			maxSize:  len(logArchiveTruncationNote) + 9,
+			expected: map[string]string{"runner.log": "short", "experiment.log": logArchiveTruncationNote + "tail"},
+		},
+		"Test Positive-3": {
+			logs:     map[string]string{"runner.log": strings.Repeat("é", 30)},
+			maxSize:  len(logArchiveTruncationNote) + 5,
+			expected: map[string]string{"runner.log": logArchiveTruncationNote + "éé"},
+		},
+		"Test Positive-4": {
+			logs:     map[string]string{"runner.log": "fake logs"},
+			maxSize:  3,
+			expected: map[string]string{"runner.log": ""},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			truncated := truncateChaosLogs(mock.logs, mock.maxSize)
+			if !reflect.DeepEqual(truncated, mock.expected) {
+				t.Fatalf("Test %q failed: expected %v, got: %v", name, mock.expected, truncated)
+			}
+			size := 0
+			for _, log := range truncated {
+				size += len(log)
+			}
+			if size > mock.maxSize {
+				t.Fatalf("Test %q failed: expected the size of logs within %v, got: %v", name, mock.maxSize, size)
+			}
+		})
+	}
+}
+
+func TestForceRemoveChaosResourcesAcrossNamespaces(t *testing.T) {
+	tests := map[string]struct {
+		pods          []corev1.Pod
+		jobs          []batchv1.Job
+		retained      int
+		expectedEvent string
+	}{
+		"Test Positive-1": {
+			pods: []corev1.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Name: "runner", Namespace: "litmus", Labels: map[string]string{"chaosUID": "fake-uid"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "helper", Namespace: "app", Labels: map[string]string{"chaosUID": "fake-uid"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "app", Labels: map[string]string{"chaosUID": "other-uid"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "archiver", Namespace: "litmus", Labels: map[string]string{"chaosUID": "fake-uid", "app.kubernetes.io/component": logArchiveComponent}}},
+			},
+			jobs: []batchv1.Job{
+				{ObjectMeta: metav1.ObjectMeta{Name: "experiment", Namespace: "litmus", Labels: map[string]string{"chaosUID": "fake-uid"}}},
+			},
+			retained:      2,
+			expectedEvent: "Normal ChaosResourcesDeleted Deleted chaos resources, namespace app: pods [helper]; namespace litmus: pods [runner], jobs [experiment]",
+		},
+		"Test Positive-2": {
+			expectedEvent: "",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-cleanup",
+						Namespace: "litmus",
+						UID:       "fake-uid",
+					},
+				},
+			}
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-cleanup", Namespace: "litmus"}}
+			r := CreateFakeClient(t)
+			for i := range mock.pods {
+				if err := r.client.Create(context.TODO(), &mock.pods[i]); err != nil {
+					t.Fatalf("Unable to create pod: %v", err)
+				}
+			}
+			for i := range mock.jobs {
+				if err := r.client.Create(context.TODO(), &mock.jobs[i]); err != nil {
+					t.Fatalf("Unable to create job: %v", err)
+				}
+			}
+
+			if err := r.forceRemoveAllChaosPods(engine, request); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			chaosPodList, err := r.getChaosPods(engine, request)
+			if err != nil || len(chaosPodList.Items) != 0 {
+				t.Fatalf("Test %q failed: expected chaos pods to be deleted in all namespaces, got: %v, err: %v", name, chaosPodList, err)
+			}
+			podList := &corev1.PodList{}
+			if err := r.client.List(context.TODO(), podList); err != nil || len(podList.Items) != mock.retained {
+				t.Fatalf("Test %q failed: expected the pods of other chaosengines to be retained, got: %v, err: %v", name, podList, err)
+			}
+
+			recorder := r.recorder.(*record.FakeRecorder)
+			var event string
+			select {
+			case event = <-recorder.Events:
+			default:
+			}
+			if event != mock.expectedEvent {
+				t.Fatalf("Test %q failed: expected event %q, got: %q", name, mock.expectedEvent, event)
+			}
+		})
+	}
+}
+
+func TestRemoveChaosArtifacts(t *testing.T) {
+	networkPolicies := schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}
+	tests := map[string]struct {
+		cleanupResources []config.CleanupResource
+		deleted          []string
+		retained         []string
+	}{
+		"Test Positive-1": {
+			cleanupResources: []config.CleanupResource{
+				{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+			},
+			deleted:  []string{"chaos-policy"},
+			retained: []string{"other-policy"},
+		},
+		"Test Positive-2": {
+			cleanupResources: nil,
+			retained:         []string{"chaos-policy", "other-policy"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			previousConfig := config.Get()
+			defer config.Set(previousConfig)
+			operatorConfig := config.Get()
+			operatorConfig.CleanupResources = mock.cleanupResources
+			config.Set(operatorConfig)
+
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-artifacts",
+						Namespace: "litmus",
+						UID:       "fake-uid",
+					},
+				},
+			}
+			r := CreateFakeClient(t)
+			for policyName, chaosUID := range map[string]string{"chaos-policy": "fake-uid", "other-policy": "other-uid"} {
+				policy := &unstructured.Unstructured{}
+				policy.SetAPIVersion("networking.k8s.io/v1")
+				policy.SetKind("NetworkPolicy")
+				policy.SetName(policyName)
+				policy.SetNamespace("app")
+				policy.SetLabels(map[string]string{"chaosUID": chaosUID})
+				if _, err := r.dynamicClient.Resource(networkPolicies).Namespace("app").Create(policy, metav1.CreateOptions{}); err != nil {
+					t.Fatalf("Unable to create networkpolicy: %v", err)
+				}
+			}
+
+			if err := r.removeChaosArtifacts(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			for _, policyName := range mock.deleted {
+				if _, err := r.dynamicClient.Resource(networkPolicies).Namespace("app").Get(policyName, metav1.GetOptions{}); err == nil {
+					t.Fatalf("Test %q failed: expected networkpolicy %s to be deleted", name, policyName)
+				}
+			}
+			for _, policyName := range mock.retained {
+				if _, err := r.dynamicClient.Resource(networkPolicies).Namespace("app").Get(policyName, metav1.GetOptions{}); err != nil {
+					t.Fatalf("Test %q failed: expected networkpolicy %s to be retained, err: %v", name, policyName, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGracefullyRemoveChaosResourcesWithPolicy(t *testing.T) {
+	tenSeconds := int32(10)
+	tests := map[string]struct {
+		policy         v1alpha1.CleanUpPolicy
+		verdict        string
+		ttl            *int32
+		completedSince time.Duration
+		isDeleted      bool
+		isRequeued     bool
+	}{
+		"Test Positive-1": {
+			policy:    v1alpha1.CleanUpPolicyDelete,
+			verdict:   "Fail",
+			isDeleted: true,
+		},
+		"Test Positive-2": {
+			policy:    v1alpha1.CleanUpPolicyRetainOnFailure,
+			verdict:   "Pass",
+			isDeleted: true,
+		},
+		"Test Positive-3": {
+			policy:    v1alpha1.CleanUpPolicyRetainOnFailure,
+			verdict:   "Fail",
+			isDeleted: false,
+		},
+		"Test Positive-4": {
+			policy:         v1alpha1.CleanUpPolicyRetain,
+			verdict:        "Pass",
+			ttl:            &tenSeconds,
+			completedSince: time.Second,
+			isDeleted:      false,
+			isRequeued:     true,
+		},
+		"Test Positive-5": {
+			policy:         v1alpha1.CleanUpPolicyRetainOnFailure,
+			verdict:        "Fail",
+			ttl:            &tenSeconds,
+			completedSince: time.Minute,
+			isDeleted:      true,
+		},
+		"Test Positive-6": {
+			policy:     v1alpha1.CleanUpPolicyRetainOnFailure,
+			verdict:    "Awaited",
+			isDeleted:  false,
+			isRequeued: true,
+		},
+		"Test Positive-7": {
+			policy:     v1alpha1.CleanUpPolicyRetainOnFailure,
+			verdict:    "",
+			isDeleted:  false,
+			isRequeued: true,
+		},
+		"Test Positive-8": {
+			policy:         v1alpha1.CleanUpPolicyRetainOnFailure,
+			verdict:        "Awaited",
+			ttl:            &tenSeconds,
+			completedSince: time.Minute,
+			isDeleted:      true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			completionTime := metav1.NewTime(time.Now().Add(-mock.completedSince))
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-policy",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						JobCleanUpPolicy:        mock.policy,
+						TTLSecondsAfterFinished: mock.ttl,
+						Experiments:             []v1alpha1.ExperimentList{{Name: "exp-1"}},
+					},
+					Status: v1alpha1.ChaosEngineStatus{
+						EngineStatus:   v1alpha1.EngineStatusCompleted,
+						CompletionTime: &completionTime,
+						Experiments:    []v1alpha1.ExperimentStatuses{{Name: "exp-1", Verdict: mock.verdict}},
+					},
+				},
+			}
+			chaosPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-policy-runner",
+					Namespace: "test",
+					Labels:    map[string]string{"app": "test-policy", "chaosUID": "fake-uid"},
+				},
+			}
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-policy", Namespace: "test"}}
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), chaosPod); err != nil {
+				t.Fatalf("Unable to create pod: %v", err)
+			}
+
+			result, err := r.gracefullyRemoveDefaultChaosResources(engine, request)
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if (result.RequeueAfter > 0) != mock.isRequeued {
+				t.Fatalf("Test %q failed: expected requeue to be %v, got: %v", name, mock.isRequeued, result.RequeueAfter)
+			}
+			err = r.client.Get(context.TODO(), types.NamespacedName{Name: chaosPod.Name, Namespace: chaosPod.Namespace}, &corev1.Pod{})
+			if mock.isDeleted != k8serrors.IsNotFound(err) {
+				t.Fatalf("Test %q failed: expected pod deletion to be %v, err: %v", name, mock.isDeleted, err)
+			}
+		})
+	}
+}
+
+func TestRemoveChaosResults(t *testing.T) {
+	deletionTimestamp := metav1.Now()
+	tests := map[string]struct {
+		policy            v1alpha1.CleanUpPolicy
+		deletionTimestamp *metav1.Time
+		isDeleted         bool
+	}{
+		"Test Positive-1": {
+			policy:            v1alpha1.CleanUpPolicyDelete,
+			deletionTimestamp: &deletionTimestamp,
+			isDeleted:         true,
+		},
+		"Test Positive-2": {
+			policy:            v1alpha1.CleanUpPolicyRetain,
+			deletionTimestamp: &deletionTimestamp,
+			isDeleted:         false,
+		},
+		"Test Positive-3": {
+			policy:            v1alpha1.CleanUpPolicyDelete,
+			deletionTimestamp: nil,
+			isDeleted:         false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "test-results",
+						Namespace:         "test",
+						UID:               "fake-uid",
+						DeletionTimestamp: mock.deletionTimestamp,
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosResultCleanUpPolicy: mock.policy,
+					},
+				},
+			}
+			result := &v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-results-exp-1",
+					Namespace: "test",
+					Labels:    map[string]string{"chaosUID": "fake-uid"},
+				},
+			}
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), result); err != nil {
+				t.Fatalf("Unable to create chaosresult: %v", err)
+			}
+
+			if err := r.removeChaosResults(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			err := r.client.Get(context.TODO(), types.NamespacedName{Name: result.Name, Namespace: result.Namespace}, &v1alpha1.ChaosResult{})
+			if mock.isDeleted != k8serrors.IsNotFound(err) {
+				t.Fatalf("Test %q failed: expected chaosresult deletion to be %v, err: %v", name, mock.isDeleted, err)
+			}
+		})
+	}
+}
+
+func TestPruneChaosResults(t *testing.T) {
+	tests := map[string]struct {
+		retentionLimit   int
+		activeEngineUIDs []string
+		retained         []string
+	}{
+		"Test Positive-1": {
+			retentionLimit: 2,
+			retained:       []string{"current-result", "newest-result"},
+		},
+		"Test Positive-2": {
+			retentionLimit: 0,
+			retained:       []string{"current-result", "oldest-result", "older-result", "newest-result"},
+		},
+		"Test Positive-3": {
+			retentionLimit:   2,
+			activeEngineUIDs: []string{"uid-1"},
+			retained:         []string{"current-result", "oldest-result"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			previousConfig := config.Get()
+			defer config.Set(previousConfig)
+			operatorConfig := config.Get()
+			operatorConfig.ChaosResultRetentionLimit = mock.retentionLimit
+			config.Set(operatorConfig)
+
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-prune",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+				},
+			}
+			now := time.Now()
+			results := map[string]struct {
+				chaosUID string
+				age      time.Duration
+			}{
+				"current-result": {chaosUID: "fake-uid", age: 4 * time.Hour},
+				"oldest-result":  {chaosUID: "uid-1", age: 3 * time.Hour},
+				"older-result":   {chaosUID: "uid-2", age: 2 * time.Hour},
+				"newest-result":  {chaosUID: "uid-3", age: time.Hour},
+			}
+			r := CreateFakeClient(t)
+			for i, uid := range mock.activeEngineUIDs {
+				activeEngine := &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("active-engine-%d", i),
+						Namespace: "test",
+						UID:       types.UID(uid),
+					},
+					Status: v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+				}
+				if err := r.client.Create(context.TODO(), activeEngine); err != nil {
+					t.Fatalf("Unable to create chaosengine: %v", err)
+				}
+			}
+			for resultName, result := range results {
+				chaosResult := &v1alpha1.ChaosResult{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              resultName,
+						Namespace:         "test",
+						Labels:            map[string]string{"chaosUID": result.chaosUID},
+						Annotations:       map[string]string{logArchiveAnnotationKey: "configmap/" + resultName + "-logs"},
+						CreationTimestamp: metav1.NewTime(now.Add(-result.age)),
+					},
+				}
+				if err := r.client.Create(context.TODO(), chaosResult); err != nil {
+					t.Fatalf("Unable to create chaosresult: %v", err)
+				}
+				logArchive := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resultName + "-logs",
+						Namespace: "test",
+						Labels:    map[string]string{"chaosUID": result.chaosUID, "app.kubernetes.io/component": logArchiveComponent},
+					},
+				}
+				if err := r.client.Create(context.TODO(), logArchive); err != nil {
+					t.Fatalf("Unable to create the log archive: %v", err)
+				}
+			}
+
+			if err := r.pruneChaosResults(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			chaosresultList := &v1alpha1.ChaosResultList{}
+			if err := r.client.List(context.TODO(), chaosresultList); err != nil {
+				t.Fatalf("Test %q failed: unable to list chaosresults, err: %v", name, err)
+			}
+			var retained []string
+			for _, result := range chaosresultList.Items {
+				retained = append(retained, result.Name)
+			}
+			if len(retained) != len(mock.retained) {
+				t.Fatalf("Test %q failed: expected chaosresults %v to be retained, got: %v", name, mock.retained, retained)
+			}
+			for _, resultName := range mock.retained {
+				if !strings.Contains(strings.Join(retained, ","), resultName) {
+					t.Fatalf("Test %q failed: expected chaosresult %s to be retained, got: %v", name, resultName, retained)
+				}
+			}
+			for resultName := range results {
+				err := r.client.Get(context.TODO(), types.NamespacedName{Name: resultName + "-logs", Namespace: "test"}, &corev1.ConfigMap{})
+				if isRetained := strings.Contains(strings.Join(retained, ","), resultName); isRetained != (err == nil) {
+					t.Fatalf("Test %q failed: expected the log archive of chaosresult %s to be retained %v, err: %v", name, resultName, isRetained, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetUnrevertedTargets(t *testing.T) {
+	tests := map[string]struct {
+		result            v1alpha1.ChaosResult
+		unrevertedTargets []string
+		isConditionSet    bool
+	}{
+		"Test Positive-1": {
+			result: v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"pod/app-2": "injected", "deployment/app": "reverted"},
+				},
+				Status: v1alpha1.ChaosResultStatus{
+					History: v1alpha1.HistoryDetails{
+						Targets: []v1alpha1.TargetDetails{{Name: "app-1", Kind: "pod", ChaosStatus: "injected"}},
+					},
+				},
+			},
+			unrevertedTargets: []string{"pod/app-1", "pod/app-2"},
+			isConditionSet:    true,
+		},
+		"Test Positive-2": {
+			result: v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"pod/app-1": "reverted"},
+				},
+				Status: v1alpha1.ChaosResultStatus{
+					History: v1alpha1.HistoryDetails{
+						Targets: []v1alpha1.TargetDetails{{Name: "app-1", Kind: "pod", ChaosStatus: "injected"}},
+					},
+				},
+			},
+			unrevertedTargets: nil,
+			isConditionSet:    false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			unrevertedTargets := getUnrevertedTargets([]v1alpha1.ChaosResult{mock.result})
+			if !reflect.DeepEqual(unrevertedTargets, mock.unrevertedTargets) {
+				t.Fatalf("Test %q failed: expected unreverted targets %v, got: %v", name, mock.unrevertedTargets, unrevertedTargets)
+			}
+
+			engine := &v1alpha1.ChaosEngine{}
+			setEngineCondition(engine, v1alpha1.ChaosEngineCondition{
+				Type:   v1alpha1.ChaosEngineConditionRevertIncomplete,
+				Status: corev1.ConditionTrue,
+			})
+			if len(unrevertedTargets) == 0 {
+				removeEngineCondition(engine, v1alpha1.ChaosEngineConditionRevertIncomplete)
+			}
+			if isConditionSet := len(engine.Status.Conditions) == 1; isConditionSet != mock.isConditionSet {
+				t.Fatalf("Test %q failed: expected RevertIncomplete condition to be %v, got: %v", name, mock.isConditionSet, engine.Status.Conditions)
+			}
+		})
+	}
+}
+
+func TestGetChaosStatus(t *testing.T) {
+	tests := map[string]struct {
+		annotations map[string]string
+		targets     []v1alpha1.TargetDetails
+		expected    []v1alpha1.TargetDetails
+		retained    map[string]string
+	}{
+		"Test Positive-1": {
+			annotations: map[string]string{"pod/app-1": "reverted", "pod/app-ns/app-2": "injected"},
+			targets:     []v1alpha1.TargetDetails{{Name: "app-1", Kind: "pod", Namespace: "app-ns", ChaosStatus: "injected"}},
+			expected: []v1alpha1.TargetDetails{
+				{Name: "app-1", Kind: "pod", Namespace: "app-ns", ChaosStatus: "reverted"},
+				{Name: "app-2", Kind: "pod", Namespace: "app-ns", ChaosStatus: "injected"},
+			},
+			retained: map[string]string{},
+		},
+		"Test Positive-2": {
+			annotations: map[string]string{"app-1": "injected", "pod/": "injected", "litmuschaos.io/log-archive": "configmap/test-logs"},
+			targets:     []v1alpha1.TargetDetails{{Name: "app-1", Kind: "pod", Namespace: "app-ns", ChaosStatus: "targeted"}},
+			expected:    []v1alpha1.TargetDetails{{Name: "app-1", Kind: "pod", Namespace: "app-ns", ChaosStatus: "targeted"}},
+			retained:    map[string]string{"app-1": "injected", "pod/": "injected", "litmuschaos.io/log-archive": "configmap/test-logs"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := v1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{Annotations: mock.annotations},
+				Status: v1alpha1.ChaosResultStatus{
+					History: v1alpha1.HistoryDetails{Targets: mock.targets},
+				},
+			}
+			targets, annotations := getChaosStatus(result)
+			if !reflect.DeepEqual(annotations, mock.retained) {
+				t.Fatalf("Test %q failed: expected annotations %v, got: %v", name, mock.retained, annotations)
+			}
+			if len(result.Annotations) != len(mock.annotations) {
+				t.Fatalf("Test %q failed: expected the annotations of chaosresult to be unchanged, got: %v", name, result.Annotations)
+			}
+			if len(targets) != len(mock.expected) {
+				t.Fatalf("Test %q failed: expected %d targets, got: %v", name, len(mock.expected), targets)
+			}
+			for i := range targets {
+				if targets[i].Name != mock.expected[i].Name || targets[i].Kind != mock.expected[i].Kind ||
+					targets[i].Namespace != mock.expected[i].Namespace || targets[i].ChaosStatus != mock.expected[i].ChaosStatus {
+					t.Fatalf("Test %q failed: expected target %v, got: %v", name, mock.expected[i], targets[i])
+				}
+				if targets[i].ChaosStatus != mock.targets[0].ChaosStatus && targets[i].LastTransitionTime == nil {
+					t.Fatalf("Test %q failed: expected the transition time of target %q to be set", name, targets[i].Name)
+				}
+			}
+		})
+	}
+}
+
+func TestGetReader(t *testing.T) {
+	tests := map[string]struct {
+		watchNamespaces []string
+		namespace       string
+		isCached        bool
+	}{
+		"Test Positive-1": {
+			watchNamespaces: nil,
+			namespace:       "app",
+			isCached:        true,
+		},
+		"Test Positive-2": {
+			watchNamespaces: []string{"litmus", "app"},
+			namespace:       "app",
+			isCached:        true,
+		},
+		"Test Positive-3": {
+			watchNamespaces: []string{"litmus"},
+			namespace:       "app",
+			isCached:        false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			previousNamespaces := chaosTypes.WatchNamespaces
+			defer func() { chaosTypes.WatchNamespaces = previousNamespaces }()
+			chaosTypes.WatchNamespaces = mock.watchNamespaces
+
+			r := CreateFakeClient(t)
+			r.apiReader = litmusFakeClientset.NewFakeClient()
+			if isCached := r.getReader(mock.namespace) == r.client; isCached != mock.isCached {
+				t.Fatalf("Test %q failed: expected the cached reader to be %v, got: %v", name, mock.isCached, isCached)
+			}
+		})
+	}
+}
+
+func TestGetChaosNamespaces(t *testing.T) {
+	tests := map[string]struct {
+		appns      string
+		pods       []corev1.Pod
+		namespaces []string
+	}{
+		"Test Positive-1": {
+			appns:      "app",
+			namespaces: []string{"app", "litmus"},
+		},
+		"Test Positive-2": {
+			pods: []corev1.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Name: "helper", Namespace: "infra"}},
+			},
+			namespaces: []string{"infra", "litmus"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cleanup", Namespace: "litmus"},
+					Spec: v1alpha1.ChaosEngineSpec{
+						Appinfo: v1alpha1.ApplicationParams{Appns: mock.appns},
+					},
+				},
+			}
+			namespaces := getChaosNamespaces(engine, &corev1.PodList{Items: mock.pods}, &batchv1.JobList{})
+			if !reflect.DeepEqual(namespaces, mock.namespaces) {
+				t.Fatalf("Test %q failed: expected namespaces %v, got: %v", name, mock.namespaces, namespaces)
+			}
+		})
+	}
+}
+
+func TestStopEngineWithChaosResultCleanUpPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy              v1alpha1.CleanUpPolicy
+		isFinalizerRetained bool
+	}{
+		"Test Positive-1": {
+			policy:              v1alpha1.CleanUpPolicyDelete,
+			isFinalizerRetained: true,
+		},
+		"Test Positive-2": {
+			policy:              v1alpha1.CleanUpPolicyRetain,
+			isFinalizerRetained: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "test-stop",
+						Namespace:  "test",
+						UID:        "fake-uid",
+						Finalizers: []string{finalizer},
+					},
+					Spec: v1alpha1.ChaosEngineSpec{
+						ChaosResultCleanUpPolicy: mock.policy,
+					},
+					Status: v1alpha1.ChaosEngineStatus{EngineStatus: v1alpha1.EngineStatusInitialized},
+				},
+			}
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "test-stop", Namespace: "test"}}
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), engine.Instance); err != nil {
+				t.Fatalf("Unable to create chaosengine: %v", err)
+			}
+
+			if _, err := r.stopEngine(engine, request, true); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			stoppedEngine := &v1alpha1.ChaosEngine{}
+			if err := r.client.Get(context.TODO(), request.NamespacedName, stoppedEngine); err != nil {
+				t.Fatalf("Test %q failed: unable to get chaosengine, err: %v", name, err)
+			}
+			if isFinalizerRetained := len(stoppedEngine.Finalizers) != 0; isFinalizerRetained != mock.isFinalizerRetained {
+				t.Fatalf("Test %q failed: expected finalizer retention to be %v, got: %v", name, mock.isFinalizerRetained, stoppedEngine.Finalizers)
+			}
+			if stoppedEngine.Status.EngineStatus != v1alpha1.EngineStatusStopped {
+				t.Fatalf("Test %q failed: expected chaosengine to be stopped, got: %v", name, stoppedEngine.Status.EngineStatus)
+			}
+		})
+	}
+}
+
+func TestUpdatChaosResult(t *testing.T) {
+	tests := map[string]struct {
+		results []v1alpha1.ChaosResult
+		updated []string
+	}{
+		"Test Positive-1": {
+			results: []v1alpha1.ChaosResult{
+				{ObjectMeta: metav1.ObjectMeta{Name: "test-status-exp-1", Namespace: "test", Labels: map[string]string{"chaosUID": "fake-uid"}, Annotations: map[string]string{"pod/app-1": "reverted"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "test-status-exp-2", Namespace: "test", Labels: map[string]string{"chaosUID": "fake-uid"}, Annotations: map[string]string{"pod/app-2": "injected"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-exp-1", Namespace: "test", Labels: map[string]string{"chaosUID": "other-uid"}, Annotations: map[string]string{"pod/app-3": "injected"}}},
+			},
+			updated: []string{"test-status-exp-1", "test-status-exp-2"},
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-status",
+						Namespace: "test",
+						UID:       "fake-uid",
+					},
+				},
+			}
+			r := CreateFakeClient(t)
+			for i := range mock.results {
+				if err := r.client.Create(context.TODO(), &mock.results[i]); err != nil {
+					t.Fatalf("Unable to create chaosresult: %v", err)
+				}
+			}
+
+			if err := r.updatChaosResult(engine); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			for _, result := range mock.results {
+				updatedResult := &v1alpha1.ChaosResult{}
+				if err := r.client.Get(context.TODO(), types.NamespacedName{Name: result.Name, Namespace: result.Namespace}, updatedResult); err != nil {
+					t.Fatalf("Test %q failed: unable to get chaosresult, err: %v", name, err)
+				}
+				isUpdated := len(updatedResult.Status.History.Targets) == 1 && len(updatedResult.Annotations) == 0
+				if isUpdated != strings.Contains(strings.Join(mock.updated, ","), result.Name) {
+					t.Fatalf("Test %q failed: unexpected chaos status update of chaosresult %s, got: %v", name, result.Name, updatedResult)
+				}
+			}
+		})
+	}
+}
+
+func TestSetEngineCondition(t *testing.T) {
+	tests := map[string]struct {
+		conditions []v1alpha1.ChaosEngineCondition
+		message    string
+		isChanged  bool
+	}{
+		"Test Positive-1": {
+			conditions: nil,
+			message:    "chaos is not reverted on the targets: pod/app-1",
+			isChanged:  true,
+		},
+		"Test Positive-2": {
+			conditions: []v1alpha1.ChaosEngineCondition{{Type: v1alpha1.ChaosEngineConditionRevertIncomplete, Status: corev1.ConditionTrue, Message: "chaos is not reverted on the targets: pod/app-1"}},
+			message:    "chaos is not reverted on the targets: pod/app-1",
+			isChanged:  false,
+		},
+		"Test Positive-3": {
+			conditions: []v1alpha1.ChaosEngineCondition{{Type: v1alpha1.ChaosEngineConditionRevertIncomplete, Status: corev1.ConditionTrue, Message: "chaos is not reverted on the targets: pod/app-1, pod/app-2"}},
+			message:    "chaos is not reverted on the targets: pod/app-1",
+			isChanged:  true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &v1alpha1.ChaosEngine{
+				Status: v1alpha1.ChaosEngineStatus{Conditions: mock.conditions},
+			}
+			isChanged := setEngineCondition(engine, v1alpha1.ChaosEngineCondition{
+				Type:    v1alpha1.ChaosEngineConditionRevertIncomplete,
+				Status:  corev1.ConditionTrue,
+				Message: mock.message,
+			})
+			if isChanged != mock.isChanged {
+				t.Fatalf("Test %q failed: expected the condition change to be %v, got: %v", name, mock.isChanged, isChanged)
+			}
+			if !isRevertIncomplete(engine) || len(engine.Status.Conditions) != 1 {
+				t.Fatalf("Test %q failed: expected the RevertIncomplete condition to be set, got: %v", name, engine.Status.Conditions)
+			}
+		})
+	}
+}
+
+func TestUpdateEngineForRestartClearsConditions(t *testing.T) {
+	engine := &chaosTypes.EngineInfo{
+		Instance: &v1alpha1.ChaosEngine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-restart",
+				Namespace:  "test",
+				Finalizers: []string{finalizer},
+			},
+			Status: v1alpha1.ChaosEngineStatus{
+				EngineStatus: v1alpha1.EngineStatusStopped,
+				Conditions:   []v1alpha1.ChaosEngineCondition{{Type: v1alpha1.ChaosEngineConditionRevertIncomplete, Status: corev1.ConditionTrue}},
+			},
+		},
+	}
+	r := CreateFakeClient(t)
+	if err := r.client.Create(context.TODO(), engine.Instance); err != nil {
+		t.Fatalf("Unable to create chaosengine: %v", err)
+	}
+
+	if err := r.updateEngineForRestart(engine); err != nil {
+		t.Fatalf("Test failed: expected error to be nil, got: %v", err)
+	}
+	restartedEngine := &v1alpha1.ChaosEngine{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "test-restart", Namespace: "test"}, restartedEngine); err != nil {
+		t.Fatalf("Test failed: unable to get chaosengine, err: %v", err)
+	}
+	if len(restartedEngine.Status.Conditions) != 0 || len(restartedEngine.Finalizers) != 0 {
+		t.Fatalf("Test failed: expected the conditions and finalizer to be cleared on restart, got: %v", restartedEngine)
+	}
+}
+
+func TestGetExperimentJobName(t *testing.T) {
+	tests := map[string]struct {
+		engineName string
+		runID      string
+		jobName    string
+	}{
+		"Test Positive-1": {
+			engineName: "test-job",
+			runID:      "",
+			jobName:    "test-job-exp-1",
+		},
+		"Test Positive-2": {
+			engineName: "test-job",
+			runID:      "abc123",
+			jobName:    "test-job-exp-1-abc123",
+		},
+		"Test Positive-3": {
+			engineName: strings.Repeat("a", 60),
+			runID:      "abc123",
+			jobName:    strings.Repeat("a", 56) + "-abc123",
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &v1alpha1.ChaosEngine{
+				ObjectMeta: metav1.ObjectMeta{Name: mock.engineName},
+				Status:     v1alpha1.ChaosEngineStatus{RunID: mock.runID},
+			}
+			if jobName := getExperimentJobName(engine, "exp-1"); jobName != mock.jobName {
+				t.Fatalf("Test %q failed: expected job name %q, got: %q", name, mock.jobName, jobName)
+			}
+		})
+	}
+}
+
+func TestValidateExistingExperimentJob(t *testing.T) {
+	tests := map[string]struct {
+		jobRunID string
+		isErr    bool
+	}{
+		"Test Positive-1": {
+			jobRunID: "abc123",
+			isErr:    false,
+		},
+		"Test Negative-1": {
+			jobRunID: "old123",
+			isErr:    true,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test", UID: "fake-uid"},
+					Status:     v1alpha1.ChaosEngineStatus{RunID: "abc123"},
+				},
+			}
+			existingJob := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-job-exp-1-abc123",
+					Namespace: "test",
+					Labels:    map[string]string{"chaosUID": "fake-uid", "chaosRunID": mock.jobRunID},
+				},
+			}
+			r := CreateFakeClient(t)
+			if err := r.client.Create(context.TODO(), existingJob); err != nil {
+				t.Fatalf("Unable to create job: %v", err)
+			}
+
+			err := r.validateExistingExperimentJob(engine, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: existingJob.Name, Namespace: "test"}})
+			if mock.isErr != (err != nil) {
+				t.Fatalf("Test %q failed: expected error to be %v, got: %v", name, mock.isErr, err)
+			}
+		})
+	}
+}
+
+func TestRunNextExperimentWithFinishedJob(t *testing.T) {
+	tests := map[string]struct {
+		condition batchv1.JobConditionType
+		status    v1alpha1.ExperimentStatus
+		verdict   v1alpha1.ResultVerdict
+	}{
+		"Test Positive-1": {
+			condition: batchv1.JobComplete,
+			status:    v1alpha1.ExperimentStatusCompleted,
+			verdict:   v1alpha1.ResultVerdictAwaited,
+		},
+		"Test Positive-2": {
+			condition: batchv1.JobFailed,
+			status:    v1alpha1.ExperimentStatusFailed,
+			verdict:   v1alpha1.ResultVerdictFailed,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			engine := &chaosTypes.EngineInfo{
+				Instance: &v1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test", UID: "fake-uid"},
+					Spec: v1alpha1.ChaosEngineSpec{
+						Experiments: []v1alpha1.ExperimentList{{Name: "exp-1"}},
+					},
+				},
+			}
+			jobList := &batchv1.JobList{
+				Items: []batchv1.Job{{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-job-exp-1", Namespace: "test", Labels: map[string]string{experimentLabelKey: "exp-1"}},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{Type: mock.condition, Status: corev1.ConditionTrue}},
+					},
+				}},
+			}
+			r := CreateFakeClient(t)
+			if err := r.runNextExperiment(engine, jobList, startReqLogger(reconcile.Request{})); err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if status := getExperimentStatus(engine.Instance, "exp-1"); status != mock.status {
+				t.Fatalf("Test %q failed: expected experiment status %q, got: %q", name, mock.status, status)
+			}
+			if verdict := getExperimentVerdict(engine.Instance, "exp-1"); verdict != string(mock.verdict) {
+				t.Fatalf("Test %q failed: expected experiment verdict %q, got: %q", name, mock.verdict, verdict)
+			}
+			if engineVerdict := getEngineVerdict(engine.Instance); mock.verdict == v1alpha1.ResultVerdictFailed && engineVerdict != v1alpha1.ResultVerdictFailed {
+				t.Fatalf("Test %q failed: expected the engine verdict to be failed, got: %q", name, engineVerdict)
+			}
+		})
+	}
+}
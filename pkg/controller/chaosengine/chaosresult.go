@@ -0,0 +1,126 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/chaos-operator/pkg/config"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// removeChaosResults deletes the chaosresults of the chaosengine, if the chaosengine is being
+// deleted and its chaosResultCleanUpPolicy is delete. The chaosresults are retained by default
+func (r *ReconcileChaosEngine) removeChaosResults(engine *chaosTypes.EngineInfo) error {
+	if engine.Instance.DeletionTimestamp == nil || engine.Instance.Spec.ChaosResultCleanUpPolicy != litmuschaosv1alpha1.CleanUpPolicyDelete {
+		return nil
+	}
+
+	chaosresultList, err := r.getChaosResultsForEngine(engine)
+	if err != nil {
+		return err
+	}
+	for i := range chaosresultList.Items {
+		if err := r.client.Delete(context.TODO(), &chaosresultList.Items[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete chaosresult '%s', err: %v", chaosresultList.Items[i].Name, err)
+		}
+	}
+	chaosTypes.Log.Info("Deleted the chaosresults of chaosengine", "chaosengine", engine.Instance.Name, "chaosresults", len(chaosresultList.Items))
+
+	// the log archives aren't owned by the chaosengine, as they are retained along with the chaosresults
+	logArchiveLabels := client.MatchingLabels{"chaosUID": string(engine.Instance.UID), "app.kubernetes.io/component": logArchiveComponent}
+	if err := r.client.DeleteAllOf(context.TODO(), &corev1.ConfigMap{}, client.InNamespace(engine.Instance.Namespace), logArchiveLabels); err != nil {
+		return fmt.Errorf("unable to delete the log archives of chaosengine, err: %v", err)
+	}
+	return nil
+}
+
+// isChaosResultRemovalPending checks whether the chaosresults of the chaosengine are to be deleted along with it,
+// in which case the finalizer of the chaosengine is retained even after it is stopped
+func isChaosResultRemovalPending(cr *litmuschaosv1alpha1.ChaosEngine) bool {
+	return cr.DeletionTimestamp == nil && cr.Spec.ChaosResultCleanUpPolicy == litmuschaosv1alpha1.CleanUpPolicyDelete
+}
+
+// pruneChaosResults deletes the oldest chaosresults of the namespace of chaosengine along with their log archives,
+// which exceed the chaosResultRetentionLimit of the operator. The chaosresults of the given chaosengine and of the
+// chaosengines, which are still running, are never pruned
+func (r *ReconcileChaosEngine) pruneChaosResults(engine *chaosTypes.EngineInfo) error {
+	retentionLimit := config.Get().ChaosResultRetentionLimit
+	if retentionLimit <= 0 {
+		return nil
+	}
+
+	chaosresultList := &litmuschaosv1alpha1.ChaosResultList{}
+	if err := r.client.List(context.TODO(), chaosresultList, client.InNamespace(engine.Instance.Namespace)); err != nil {
+		return err
+	}
+	if len(chaosresultList.Items) <= retentionLimit {
+		return nil
+	}
+
+	activeEngines, err := r.getActiveEngineUIDs(engine.Instance.Namespace)
+	if err != nil {
+		return err
+	}
+	activeEngines[string(engine.Instance.UID)] = true
+
+	chaosresults := chaosresultList.Items
+	sort.SliceStable(chaosresults, func(i, j int) bool {
+		return chaosresults[i].CreationTimestamp.Before(&chaosresults[j].CreationTimestamp)
+	})
+
+	excess := len(chaosresults) - retentionLimit
+	for i := 0; i < len(chaosresults) && excess > 0; i++ {
+		if activeEngines[chaosresults[i].Labels["chaosUID"]] {
+			continue
+		}
+		// the log archive isn't owned by the chaosresult, so it is removed before the chaosresult
+		if err := r.removeLogArchive(chaosresults[i].Namespace, chaosresults[i].Annotations[logArchiveAnnotationKey]); err != nil {
+			return err
+		}
+		if err := r.client.Delete(context.TODO(), &chaosresults[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to prune chaosresult '%s', err: %v", chaosresults[i].Name, err)
+		}
+		excess--
+	}
+	return nil
+}
+
+// getActiveEngineUIDs returns the UIDs of the chaosengines of the namespace, which are neither completed nor stopped
+func (r *ReconcileChaosEngine) getActiveEngineUIDs(namespace string) (map[string]bool, error) {
+	engineList := &litmuschaosv1alpha1.ChaosEngineList{}
+	if err := r.client.List(context.TODO(), engineList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	activeEngines := map[string]bool{}
+	for _, chaosEngine := range engineList.Items {
+		switch chaosEngine.Status.EngineStatus {
+		case litmuschaosv1alpha1.EngineStatusCompleted, litmuschaosv1alpha1.EngineStatusStopped:
+		default:
+			activeEngines[string(chaosEngine.UID)] = true
+		}
+	}
+	return activeEngines, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/litmuschaos/chaos-operator/pkg/config"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// getChaosJobs lists the chaos jobs of the chaosengine across all the namespaces
+// They are listed through the uncached reader, if the manager doesn't cache all the namespaces
+func (r *ReconcileChaosEngine) getChaosJobs(engine *chaosTypes.EngineInfo) (*batchv1.JobList, error) {
+	chaosJobList := &batchv1.JobList{}
+	opts := []client.ListOption{
+		client.MatchingLabels{"chaosUID": string(engine.Instance.UID)},
+	}
+	if err := r.getReader(v1.NamespaceAll).List(context.TODO(), chaosJobList, opts...); err != nil {
+		return nil, err
+	}
+	return chaosJobList, nil
+}
+
+// getChaosNamespaces returns the namespaces touched by the chaosengine, i.e. its own namespace, the namespace
+// of the application and the namespaces which contain its chaos pods or jobs, in sorted order
+func getChaosNamespaces(engine *chaosTypes.EngineInfo, podList *corev1.PodList, jobList *batchv1.JobList) []string {
+	namespaces := map[string]bool{engine.Instance.Namespace: true}
+	if appNamespace := engine.Instance.Spec.Appinfo.Appns; appNamespace != "" {
+		namespaces[appNamespace] = true
+	}
+	for _, chaosPod := range podList.Items {
+		namespaces[chaosPod.Namespace] = true
+	}
+	for _, chaosJob := range jobList.Items {
+		namespaces[chaosJob.Namespace] = true
+	}
+
+	var namespaceList []string
+	for namespace := range namespaces {
+		namespaceList = append(namespaceList, namespace)
+	}
+	sort.Strings(namespaceList)
+	return namespaceList
+}
+
+// summarizeChaosResources lists the names of the chaos pods and jobs, grouped by their namespace
+func summarizeChaosResources(podList *corev1.PodList, jobList *batchv1.JobList) string {
+	pods := map[string][]string{}
+	jobs := map[string][]string{}
+	namespaces := map[string]bool{}
+	for _, chaosPod := range podList.Items {
+		pods[chaosPod.Namespace] = append(pods[chaosPod.Namespace], chaosPod.Name)
+		namespaces[chaosPod.Namespace] = true
+	}
+	for _, chaosJob := range jobList.Items {
+		jobs[chaosJob.Namespace] = append(jobs[chaosJob.Namespace], chaosJob.Name)
+		namespaces[chaosJob.Namespace] = true
+	}
+
+	var namespaceList []string
+	for namespace := range namespaces {
+		namespaceList = append(namespaceList, namespace)
+	}
+	sort.Strings(namespaceList)
+
+	var summary []string
+	for _, namespace := range namespaceList {
+		var resources []string
+		if len(pods[namespace]) != 0 {
+			resources = append(resources, fmt.Sprintf("pods [%s]", strings.Join(pods[namespace], ", ")))
+		}
+		if len(jobs[namespace]) != 0 {
+			resources = append(resources, fmt.Sprintf("jobs [%s]", strings.Join(jobs[namespace], ", ")))
+		}
+		summary = append(summary, fmt.Sprintf("namespace %s: %s", namespace, strings.Join(resources, ", ")))
+	}
+	return strings.Join(summary, "; ")
+}
+
+// reportRemovedChaosResources generates an event, which lists the chaos pods and jobs removed for the chaosengine
+func (r *ReconcileChaosEngine) reportRemovedChaosResources(engine *chaosTypes.EngineInfo, podList *corev1.PodList, jobList *batchv1.JobList) {
+	if len(podList.Items) == 0 && len(jobList.Items) == 0 {
+		return
+	}
+	r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosResourcesDeleted", "Deleted chaos resources, %s", summarizeChaosResources(podList, jobList))
+}
+
+// removeChaosArtifacts deletes the resources of the configured cleanup resources, which are labeled with the
+// chaosUID of the chaosengine, from all the namespaces. The failures are aggregated into a single error,
+// so that a failure doesn't prevent the deletion of the remaining resources
+func (r *ReconcileChaosEngine) removeChaosArtifacts(engine *chaosTypes.EngineInfo) error {
+	listOptions := v1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{"chaosUID": string(engine.Instance.UID)}).String(),
+	}
+	propagationPolicy := v1.DeletePropagationBackground
+
+	var failures []string
+	for _, cleanupResource := range config.Get().CleanupResources {
+		gvr := cleanupResource.GroupVersionResource()
+		artifactList, err := r.dynamicClient.Resource(gvr).List(listOptions)
+		if err != nil {
+			// the resource isn't served by the cluster, so there is nothing to delete
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			failures = append(failures, fmt.Sprintf("unable to list %v, err: %v", gvr.String(), err))
+			continue
+		}
+		for _, artifact := range artifactList.Items {
+			err := r.dynamicClient.Resource(gvr).Namespace(artifact.GetNamespace()).Delete(artifact.GetName(), &v1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				failures = append(failures, fmt.Sprintf("unable to delete %s %s/%s, err: %v", gvr.Resource, artifact.GetNamespace(), artifact.GetName(), err))
+			}
+		}
+	}
+	if len(failures) != 0 {
+		return fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// inlineConfigMapComponent is the value of component label for the configmaps created from the inline data
+const inlineConfigMapComponent = "inline-configmap"
+
+// getInlineConfigMaps returns the configmaps with inline data, declared on the runner and the experiments of the chaosengine
+// The same configmap can be declared multiple times, as long as the data doesn't conflict
+func getInlineConfigMaps(engine *chaosTypes.EngineInfo) ([]litmuschaosv1alpha1.ConfigMap, error) {
+	configMaps := append([]litmuschaosv1alpha1.ConfigMap{}, engine.Instance.Spec.Components.Runner.ConfigMaps...)
+	for _, exp := range engine.Instance.Spec.Experiments {
+		configMaps = append(configMaps, exp.Spec.Components.ConfigMaps...)
+	}
+
+	var inlineConfigMaps []litmuschaosv1alpha1.ConfigMap
+	dataByName := map[string]map[string]string{}
+	for _, configMap := range configMaps {
+		if configMap.Data == nil {
+			continue
+		}
+		if data, ok := dataByName[configMap.Name]; ok {
+			if !reflect.DeepEqual(data, configMap.Data) {
+				return nil, fmt.Errorf("configmap '%s' is declared with conflicting data", configMap.Name)
+			}
+			continue
+		}
+		dataByName[configMap.Name] = configMap.Data
+		inlineConfigMaps = append(inlineConfigMaps, configMap)
+	}
+	return inlineConfigMaps, nil
+}
+
+// createInlineConfigMaps creates the configmaps from the inline data of the chaosengine, owned by the chaosengine
+// The existing configmaps are updated if owned by the chaosengine, otherwise they are left untouched
+func (r *ReconcileChaosEngine) createInlineConfigMaps(engine *chaosTypes.EngineInfo) error {
+	inlineConfigMaps, err := getInlineConfigMaps(engine)
+	if err != nil {
+		return err
+	}
+
+	for _, inlineConfigMap := range inlineConfigMaps {
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      inlineConfigMap.Name,
+				Namespace: engine.Instance.Namespace,
+				Labels: withRunIDLabel(engine.Instance, map[string]string{
+					"chaosUID":                    string(engine.Instance.UID),
+					"app.kubernetes.io/component": inlineConfigMapComponent,
+					"app.kubernetes.io/part-of":   "litmus",
+				}),
+			},
+			Data: inlineConfigMap.Data,
+		}
+		if err := controllerutil.SetControllerReference(engine.Instance, configMap, r.scheme); err != nil {
+			return err
+		}
+
+		existing := &corev1.ConfigMap{}
+		err := r.client.Get(context.TODO(), types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, existing)
+		if k8serrors.IsNotFound(err) {
+			if err := r.client.Create(context.TODO(), configMap); err != nil {
+				return fmt.Errorf("unable to create configmap '%s', err: %v", configMap.Name, err)
+			}
+			chaosTypes.Log.Info("Created configmap from the inline data", "Namespace", configMap.Namespace, "Name", configMap.Name)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !v1.IsControlledBy(existing, engine.Instance) {
+			return fmt.Errorf("configmap '%s' already exists and is not owned by the chaosengine", configMap.Name)
+		}
+		if reflect.DeepEqual(existing.Data, configMap.Data) {
+			continue
+		}
+		existing.Data = configMap.Data
+		if err := r.client.Update(context.TODO(), existing); err != nil {
+			return fmt.Errorf("unable to update configmap '%s', err: %v", existing.Name, err)
+		}
+	}
+	return nil
+}
+
+// removeInlineConfigMaps removes the configmaps created from the inline data of the chaosengine
+func (r *ReconcileChaosEngine) removeInlineConfigMaps(engine *chaosTypes.EngineInfo) error {
+	err := r.client.DeleteAllOf(context.TODO(), &corev1.ConfigMap{}, client.InNamespace(engine.Instance.Namespace), client.MatchingLabels{
+		"chaosUID":                    string(engine.Instance.UID),
+		"app.kubernetes.io/component": inlineConfigMapComponent,
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete the inline configmaps, err: %v", err)
+	}
+	return nil
+}
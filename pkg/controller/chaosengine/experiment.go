@@ -0,0 +1,472 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/litmuschaos/elves/kubernetes/container"
+	"github.com/litmuschaos/elves/kubernetes/pod"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/chaos-operator/pkg/controller/resource"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+	"github.com/litmuschaos/chaos-operator/pkg/controller/utils"
+	"github.com/litmuschaos/chaos-operator/pkg/controller/watcher"
+)
+
+const (
+	// experimentJobComponent is the value of component label for the experiment jobs
+	experimentJobComponent = "experiment-job"
+	// experimentLabelKey is the label key, which contains the name of the experiment of the job
+	experimentLabelKey = "chaosExperiment"
+)
+
+// runnerlessRunner doesn't launch any runner, the operator creates the experiment jobs itself
+// from the ChaosExperiment definitions, and runs the experiments sequentially in the order of the chaosengine
+type runnerlessRunner struct{}
+
+func (*runnerlessRunner) launch(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo, reqLogger logr.Logger) error {
+	if len(engine.AppExperiments) == 0 {
+		return errors.New("application experiment list is empty")
+	}
+
+	jobList, err := r.getExperimentJobs(engine)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	if err := r.runNextExperiment(engine, jobList, reqLogger); err != nil {
+		return err
+	}
+	if err := r.client.Patch(context.TODO(), engine.Instance, patch); err != nil {
+		return fmt.Errorf("unable to patch experiment statuses of chaosEngine Resource, due to error: %v", err)
+	}
+	return nil
+}
+
+func (*runnerlessRunner) isCompleted(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo) bool {
+	for _, exp := range engine.Instance.Spec.Experiments {
+		if !isExperimentFinished(getExperimentStatus(engine.Instance, exp.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// runNextExperiment updates the experiment statuses from the experiment jobs, and creates
+// the job of the first experiment which is not started yet, once the previous experiments are finished
+func (r *ReconcileChaosEngine) runNextExperiment(engine *chaosTypes.EngineInfo, jobList *batchv1.JobList, reqLogger logr.Logger) error {
+	for _, exp := range engine.Instance.Spec.Experiments {
+		if isExperimentFinished(getExperimentStatus(engine.Instance, exp.Name)) {
+			continue
+		}
+
+		if job := getExperimentJob(jobList, exp.Name); job != nil {
+			if !isJobFinished(job) {
+				setExperimentStatus(engine.Instance, exp.Name, job.Name, litmuschaosv1alpha1.ExperimentStatusRunning)
+				return nil
+			}
+			if isJobFailed(job) {
+				setExperimentStatus(engine.Instance, exp.Name, job.Name, litmuschaosv1alpha1.ExperimentStatusFailed)
+				setExperimentVerdict(engine.Instance, exp.Name, litmuschaosv1alpha1.ResultVerdictFailed)
+				continue
+			}
+			setExperimentStatus(engine.Instance, exp.Name, job.Name, litmuschaosv1alpha1.ExperimentStatusCompleted)
+			continue
+		}
+
+		chaosExperiment := &litmuschaosv1alpha1.ChaosExperiment{}
+		err := r.client.Get(context.TODO(), types.NamespacedName{Name: exp.Name, Namespace: engine.Instance.Namespace}, chaosExperiment)
+		if k8serrors.IsNotFound(err) {
+			r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ExperimentNotFound", "ChaosExperiment %s not found in namespace %s, skipping it", exp.Name, engine.Instance.Namespace)
+			setExperimentStatus(engine.Instance, exp.Name, "", litmuschaosv1alpha1.ExperimentStatusNotFound)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		experimentJob, err := newExperimentJobForCR(engine, exp, chaosExperiment)
+		if err != nil {
+			return err
+		}
+		// Set the chaosengine as the controller owner of experiment job, so that
+		// it gets garbage collected along with the chaosengine
+		if err := controllerutil.SetControllerReference(engine.Instance, experimentJob, r.scheme); err != nil {
+			return err
+		}
+		reqLogger.Info("Creating a new experiment Job", "Job.Namespace", experimentJob.Namespace, "Job.Name", experimentJob.Name)
+		if err := r.client.Create(context.TODO(), experimentJob); err != nil {
+			if !k8serrors.IsAlreadyExists(err) {
+				return err
+			}
+			// the job may be missing from the cache, but a job of a previous run must not be tracked as the current one
+			if err := r.validateExistingExperimentJob(engine, experimentJob); err != nil {
+				return err
+			}
+		}
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ExperimentJobCreated", "Experiment job %s created for the experiment %s", experimentJob.Name, exp.Name)
+		setExperimentStatus(engine.Instance, exp.Name, experimentJob.Name, litmuschaosv1alpha1.ExperimentStatusRunning)
+		return nil
+	}
+	return nil
+}
+
+// validateExistingExperimentJob checks that the existing job with the name of the experiment job belongs to the
+// current run of the chaosengine, and is not being deleted
+func (r *ReconcileChaosEngine) validateExistingExperimentJob(engine *chaosTypes.EngineInfo, experimentJob *batchv1.Job) error {
+	existingJob := &batchv1.Job{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: experimentJob.Name, Namespace: experimentJob.Namespace}, existingJob); err != nil {
+		return fmt.Errorf("unable to get the existing experiment job '%s', err: %v", experimentJob.Name, err)
+	}
+	if existingJob.Labels["chaosUID"] != string(engine.Instance.UID) || existingJob.Labels[watcher.ChaosRunIDLabelKey] != engine.Instance.Status.RunID || existingJob.DeletionTimestamp != nil {
+		return fmt.Errorf("experiment job '%s' already exists from a previous run of the chaosengine", experimentJob.Name)
+	}
+	return nil
+}
+
+// getExperimentJobName returns the name of the experiment job of the current execution of the chaosengine
+// The name is truncated to 63 characters, as it is used as the job-name label of the experiment pod
+func getExperimentJobName(cr *litmuschaosv1alpha1.ChaosEngine, experimentName string) string {
+	name := cr.Name + "-" + experimentName
+	suffix := ""
+	if cr.Status.RunID != "" {
+		suffix = "-" + cr.Status.RunID
+	}
+	if maxLength := validation.DNS1123LabelMaxLength - len(suffix); len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-.")
+	}
+	return name + suffix
+}
+
+// getExperimentJobs lists the experiment jobs created by the operator for the chaosengine
+func (r *ReconcileChaosEngine) getExperimentJobs(engine *chaosTypes.EngineInfo) (*batchv1.JobList, error) {
+	jobList := &batchv1.JobList{}
+	opts := []client.ListOption{
+		client.InNamespace(engine.Instance.Namespace),
+		client.MatchingLabels(withRunIDLabel(engine.Instance, map[string]string{"chaosUID": string(engine.Instance.UID), "app.kubernetes.io/component": experimentJobComponent})),
+	}
+	if err := r.client.List(context.TODO(), jobList, opts...); err != nil {
+		return nil, err
+	}
+	return jobList, nil
+}
+
+// getExperimentJob returns the experiment job of the given experiment, ignoring the jobs of the previous runs under deletion
+func getExperimentJob(jobList *batchv1.JobList, experimentName string) *batchv1.Job {
+	for i := range jobList.Items {
+		if jobList.Items[i].Labels[experimentLabelKey] == experimentName && jobList.Items[i].DeletionTimestamp == nil {
+			return &jobList.Items[i]
+		}
+	}
+	return nil
+}
+
+// isJobFinished checks whether the job has succeeded or failed
+func isJobFinished(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// isJobFailed checks whether the job has failed
+func isJobFailed(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// isExperimentFinished checks whether the experiment doesn't need to be run anymore
+func isExperimentFinished(status litmuschaosv1alpha1.ExperimentStatus) bool {
+	switch status {
+	case litmuschaosv1alpha1.ExperimentStatusCompleted, litmuschaosv1alpha1.ExperimentStatusFailed, litmuschaosv1alpha1.ExperimentStatusNotFound:
+		return true
+	}
+	return false
+}
+
+// getExperimentStatus returns the status of the given experiment from the engine status
+func getExperimentStatus(instance *litmuschaosv1alpha1.ChaosEngine, name string) litmuschaosv1alpha1.ExperimentStatus {
+	for _, exp := range instance.Status.Experiments {
+		if exp.Name == name {
+			return exp.Status
+		}
+	}
+	return ""
+}
+
+// setExperimentStatus updates the status of the given experiment inside the engine status, or adds it if not present
+func setExperimentStatus(instance *litmuschaosv1alpha1.ChaosEngine, name, jobName string, status litmuschaosv1alpha1.ExperimentStatus) {
+	for i := range instance.Status.Experiments {
+		if instance.Status.Experiments[i].Name == name {
+			if instance.Status.Experiments[i].Status != status {
+				instance.Status.Experiments[i].Status = status
+				instance.Status.Experiments[i].ExpPod = jobName
+				instance.Status.Experiments[i].LastUpdateTime = v1.Now()
+			}
+			return
+		}
+	}
+	instance.Status.Experiments = append(instance.Status.Experiments, litmuschaosv1alpha1.ExperimentStatuses{
+		Name:           name,
+		ExpPod:         jobName,
+		Status:         status,
+		Verdict:        string(litmuschaosv1alpha1.ResultVerdictAwaited),
+		LastUpdateTime: v1.Now(),
+	})
+}
+
+// setExperimentVerdict updates the verdict of the given experiment inside the engine status
+func setExperimentVerdict(instance *litmuschaosv1alpha1.ChaosEngine, name string, verdict litmuschaosv1alpha1.ResultVerdict) {
+	for i := range instance.Status.Experiments {
+		if instance.Status.Experiments[i].Name == name {
+			instance.Status.Experiments[i].Verdict = string(verdict)
+			return
+		}
+	}
+}
+
+// newExperimentJobForCR defines a new experiment job from the ChaosExperiment definition,
+// with the experiment components of the chaosengine overriding the definition
+func newExperimentJobForCR(engine *chaosTypes.EngineInfo, exp litmuschaosv1alpha1.ExperimentList, chaosExperiment *litmuschaosv1alpha1.ChaosExperiment) (*batchv1.Job, error) {
+	definition := chaosExperiment.Spec.Definition
+	components := exp.Spec.Components
+
+	image := definition.Image
+	if components.ExperimentImage != "" {
+		image = components.ExperimentImage
+	}
+
+	var volumeOpts utils.VolumeOpts
+	configMaps := mergeConfigMaps(definition.ConfigMaps, components.ConfigMaps)
+	secrets := mergeSecrets(definition.Secrets, components.Secrets)
+	volumeOpts.VolumeOperations(configMaps, secrets)
+	volumeSources := *components.VolumeSources.DeepCopy()
+	volumeSources.HostFileVolumes = append(append([]litmuschaosv1alpha1.HostFile{}, definition.HostFileVolumes...), components.HostFileVolumes...)
+	volumeOpts.VolumeSourceOperations(volumeSources)
+
+	containerForExperiment := container.NewBuilder().
+		WithEnvsNew(getExperimentENV(engine.Instance, exp, definition)).
+		WithName(exp.Name).
+		WithImage(image).
+		WithImagePullPolicy(corev1.PullIfNotPresent)
+
+	if !reflect.DeepEqual(definition.SecurityContext.ContainerSecurityContext, corev1.SecurityContext{}) {
+		containerForExperiment.WithSecurityContext(definition.SecurityContext.ContainerSecurityContext)
+	}
+
+	if definition.ImagePullPolicy != "" {
+		containerForExperiment.WithImagePullPolicy(definition.ImagePullPolicy)
+	}
+
+	if definition.Command != nil {
+		containerForExperiment.WithCommandNew(definition.Command)
+	}
+
+	if definition.Args != nil {
+		containerForExperiment.WithArgumentsNew(definition.Args)
+	}
+
+	if len(volumeOpts.VolumeMounts) != 0 {
+		containerForExperiment.WithVolumeMountsNew(volumeOpts.VolumeMounts)
+	}
+
+	if !reflect.DeepEqual(components.Resources, corev1.ResourceRequirements{}) {
+		containerForExperiment.WithResourceRequirements(components.Resources)
+	}
+
+	labels := getExperimentJobLabels(engine.Instance, exp.Name, definition.Labels)
+	annotations := mergeStringMaps(definition.ExperimentAnnotations, components.ExperimentAnnotations)
+
+	podForExperiment := pod.NewBuilder().
+		WithName(exp.Name).
+		WithNamespace(engine.Instance.Namespace).
+		WithLabels(labels).
+		WithServiceAccountName(engine.Instance.Spec.ChaosServiceAccount).
+		WithRestartPolicy(corev1.RestartPolicyNever).
+		WithContainerBuilder(containerForExperiment)
+
+	if len(annotations) != 0 {
+		podForExperiment.WithAnnotations(annotations)
+	}
+
+	if components.Tolerations != nil {
+		podForExperiment.WithTolerations(components.Tolerations...)
+	}
+
+	if len(components.NodeSelector) != 0 {
+		podForExperiment.WithNodeSelector(components.NodeSelector)
+	}
+
+	if len(volumeOpts.VolumeBuilders) != 0 {
+		podForExperiment.WithVolumeBuilders(volumeOpts.VolumeBuilders)
+	}
+
+	if components.ExperimentImagePullSecrets != nil {
+		podForExperiment.WithImagePullSecrets(components.ExperimentImagePullSecrets)
+	}
+
+	podObj, err := podForExperiment.Build()
+	if err != nil {
+		return nil, err
+	}
+	podObj.Spec.Volumes = append(podObj.Spec.Volumes, volumeOpts.Volumes...)
+	podObj.Spec.HostPID = definition.HostPID
+	if !reflect.DeepEqual(definition.SecurityContext.PodSecurityContext, corev1.PodSecurityContext{}) {
+		podSecurityContext := definition.SecurityContext.PodSecurityContext
+		podObj.Spec.SecurityContext = &podSecurityContext
+	}
+
+	// the experiments are not retried, as the chaos is injected by a single run of the experiment
+	var backoffLimit int32
+	return &batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      getExperimentJobName(engine.Instance, exp.Name),
+			Namespace: engine.Instance.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: v1.ObjectMeta{
+					Labels:      labels,
+					Annotations: podObj.Annotations,
+				},
+				Spec: podObj.Spec,
+			},
+		},
+	}, nil
+}
+
+// getExperimentENV merges the ENVs of the experiment definition with the ENVs of the chaosengine,
+// and adds the ENVs which are passed to the experiment by the runner
+func getExperimentENV(cr *litmuschaosv1alpha1.ChaosEngine, exp litmuschaosv1alpha1.ExperimentList, definition litmuschaosv1alpha1.ExperimentDef) []corev1.EnvVar {
+
+	appNS := cr.Spec.Appinfo.Appns
+	if appNS == "" {
+		appNS = cr.Namespace
+	}
+
+	var envDetails utils.ENVDetails
+	envDetails.SetEnv("EXPERIMENT_NAME", exp.Name).
+		SetEnv("CHAOSENGINE", cr.Name).
+		SetEnv("CHAOS_UID", string(cr.UID)).
+		SetEnv("CHAOS_NAMESPACE", cr.Namespace).
+		SetEnv("CHAOS_SERVICE_ACCOUNT", cr.Spec.ChaosServiceAccount).
+		SetEnv("APP_LABEL", cr.Spec.Appinfo.Applabel).
+		SetEnv("APP_KIND", cr.Spec.Appinfo.AppKind).
+		SetEnv("APP_NAMESPACE", appNS).
+		SetEnv("AUXILIARY_APPINFO", cr.Spec.AuxiliaryAppInfo).
+		SetEnv("ANNOTATION_CHECK", cr.Spec.AnnotationCheck).
+		SetEnv("ANNOTATION_KEY", resource.GetAnnotationKey()).
+		SetEnv("RUN_ID", cr.Status.RunID)
+
+	if exp.Spec.Components.StatusCheckTimeouts.Delay != 0 {
+		envDetails.SetEnv("STATUS_CHECK_DELAY", strconv.Itoa(exp.Spec.Components.StatusCheckTimeouts.Delay))
+	}
+	if exp.Spec.Components.StatusCheckTimeouts.Timeout != 0 {
+		envDetails.SetEnv("STATUS_CHECK_TIMEOUT", strconv.Itoa(exp.Spec.Components.StatusCheckTimeouts.Timeout))
+	}
+
+	envs := utils.MergeEnv(definition.ENVList, exp.Spec.Components.ENV)
+	envs = utils.MergeEnv(envs, envDetails.ENV)
+	return utils.MergeEnv(envs, []corev1.EnvVar{{
+		Name:      "POD_NAME",
+		ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+	}})
+}
+
+// getExperimentJobLabels return the labels required for the experiment job
+func getExperimentJobLabels(cr *litmuschaosv1alpha1.ChaosEngine, experimentName string, definitionLabels map[string]string) map[string]string {
+	return mergeStringMaps(definitionLabels, withRunIDLabel(cr, map[string]string{
+		"app":                         cr.Name,
+		"chaosUID":                    string(cr.UID),
+		experimentLabelKey:            experimentName,
+		"app.kubernetes.io/component": experimentJobComponent,
+		"app.kubernetes.io/part-of":   "litmus",
+	}))
+}
+
+// mergeConfigMaps merges the configmaps of the experiment definition with the chaosengine, by name
+func mergeConfigMaps(base, overrides []litmuschaosv1alpha1.ConfigMap) []litmuschaosv1alpha1.ConfigMap {
+	merged := append([]litmuschaosv1alpha1.ConfigMap{}, base...)
+	for _, override := range overrides {
+		found := false
+		for i := range merged {
+			if merged[i].Name == override.Name {
+				merged[i] = override
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
+
+// mergeSecrets merges the secrets of the experiment definition with the chaosengine, by name
+func mergeSecrets(base, overrides []litmuschaosv1alpha1.Secret) []litmuschaosv1alpha1.Secret {
+	merged := append([]litmuschaosv1alpha1.Secret{}, base...)
+	for _, override := range overrides {
+		found := false
+		for i := range merged {
+			if merged[i].Name == override.Name {
+				merged[i] = override
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
+
+// mergeStringMaps merges the maps, the later maps override the keys of the former ones
+func mergeStringMaps(maps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, m := range maps {
+		for key, value := range m {
+			merged[key] = value
+		}
+	}
+	return merged
+}
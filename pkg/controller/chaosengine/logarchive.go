@@ -0,0 +1,404 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/litmuschaos/elves/kubernetes/container"
+	"github.com/litmuschaos/elves/kubernetes/pod"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+	"github.com/litmuschaos/chaos-operator/pkg/controller/utils"
+)
+
+const (
+	// logArchiveComponent is the value of component label for the log archive configmap and archiver pod
+	logArchiveComponent = "log-archive"
+	// logArchiveUIDLabelKey is the label key of the archiver pod, which contains the uid of the chaosengine
+	logArchiveUIDLabelKey = "logArchiveUID"
+	// logArchiveAnnotationKey is the annotation of the chaosresults, which refers to the location of the archived logs
+	logArchiveAnnotationKey = "litmuschaos.io/log-archive"
+	// defaultLogArchiveTailLines is the number of lines archived from each container, if not provided in the chaosengine
+	defaultLogArchiveTailLines int64 = 500
+	// defaultLogArchiveImage is the image of the archiver pod, if not provided in the chaosengine
+	defaultLogArchiveImage = "busybox:1.32"
+	// logArchiveMountPath is the path of the log archive configmap inside the archiver pod
+	logArchiveMountPath = "/logs"
+	// logArchiveClaimMountPath is the path of the pvc inside the archiver pod
+	logArchiveClaimMountPath = "/archive"
+	// maxLogArchiveSize is the maximum size of the archived logs, which keeps the configmap within its 1MiB limit
+	maxLogArchiveSize = 900 * 1024
+	// logArchiveTruncationNote precedes the logs, which are truncated to fit into the log archive
+	logArchiveTruncationNote = "... truncated to fit into the log archive\n"
+)
+
+// archiveChaosLogs archives the tail of the logs of the given chaos pods, as per the log archive of the chaosengine
+func (r *ReconcileChaosEngine) archiveChaosLogs(engine *chaosTypes.EngineInfo, podList *corev1.PodList) error {
+	logArchive := engine.Instance.Spec.LogArchive
+	if logArchive == nil || len(podList.Items) == 0 {
+		return nil
+	}
+	if err := validateLogArchive(logArchive); err != nil {
+		return err
+	}
+
+	logs := r.getChaosPodLogs(podList, getLogArchiveTailLines(logArchive))
+	return r.persistChaosLogs(engine, logs)
+}
+
+// persistChaosLogs stores the logs in a configmap, which is copied into the pvc by an archiver pod for the pvc type.
+// The chaosresults of the chaosengine are annotated with the configmap, while the pvc is annotated once the archiver
+// pod has succeeded. The configmap isn't owned by the chaosengine, as it is retained along with the chaosresults
+func (r *ReconcileChaosEngine) persistChaosLogs(engine *chaosTypes.EngineInfo, logs map[string]string) error {
+	logArchive := engine.Instance.Spec.LogArchive
+
+	configMap := newLogArchiveConfigMapForCR(engine, truncateChaosLogs(logs, maxLogArchiveSize))
+	// the log archive of a run is created once, and it already exists if the previous archival has partially failed
+	if err := r.client.Create(context.TODO(), configMap); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("unable to create the log archive configmap '%s', err: %v", configMap.Name, err)
+	}
+
+	if logArchive.Type == litmuschaosv1alpha1.LogArchiveTypePVC {
+		archiverPod, err := newLogArchiverPodForCR(engine, configMap.Name)
+		if err != nil {
+			return err
+		}
+		if err := controllerutil.SetControllerReference(engine.Instance, archiverPod, r.scheme); err != nil {
+			return err
+		}
+		if err := r.client.Create(context.TODO(), archiverPod); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("unable to create the log archiver pod '%s', err: %v", archiverPod.Name, err)
+		}
+		chaosTypes.Log.Info("Started copying the logs of chaos pods into the pvc", "chaosengine", engine.Instance.Name, "pod", archiverPod.Name)
+		return nil
+	}
+
+	location := "configmap/" + configMap.Name
+	chaosTypes.Log.Info("Archived the logs of chaos pods", "chaosengine", engine.Instance.Name, "location", location)
+	return r.annotateChaosResultsWithLogArchive(engine, location)
+}
+
+// reconcileLogArchiver tracks the archiver pod of the pvc log archive till its completion, and removes it afterwards.
+// The chaosresults are annotated with the pvc once the logs are copied, otherwise with the configmap which still
+// contains the logs. It returns true if the archiver pod is still running
+func (r *ReconcileChaosEngine) reconcileLogArchiver(engine *chaosTypes.EngineInfo) (bool, error) {
+	logArchive := engine.Instance.Spec.LogArchive
+	if logArchive == nil || logArchive.Type != litmuschaosv1alpha1.LogArchiveTypePVC {
+		return false, nil
+	}
+
+	if err := r.removeStaleLogArchivers(engine); err != nil {
+		return false, err
+	}
+
+	name := getLogArchiveName(engine.Instance)
+	archiverPod := &corev1.Pod{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: engine.Instance.Namespace}, archiverPod); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var location string
+	switch archiverPod.Status.Phase {
+	case corev1.PodSucceeded:
+		location = "pvc/" + path.Join(logArchive.ClaimName, getLogArchiveDir(engine.Instance))
+	case corev1.PodFailed:
+		location = "configmap/" + name
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosLogArchiveFailed", "Unable to copy the logs into the pvc '%s', the logs are retained in the configmap '%s'", logArchive.ClaimName, name)
+	default:
+		return true, nil
+	}
+	if err := r.annotateChaosResultsWithLogArchive(engine, location); err != nil {
+		return false, err
+	}
+
+	// the configmap only transfers the logs into the pvc, so it is removed once the logs are copied
+	if archiverPod.Status.Phase == corev1.PodSucceeded {
+		configMap := &corev1.ConfigMap{ObjectMeta: v1.ObjectMeta{Name: name, Namespace: engine.Instance.Namespace}}
+		if err := r.client.Delete(context.TODO(), configMap); err != nil && !k8serrors.IsNotFound(err) {
+			return false, fmt.Errorf("unable to delete the log archive configmap '%s', err: %v", name, err)
+		}
+	}
+	if err := r.client.Delete(context.TODO(), archiverPod); err != nil && !k8serrors.IsNotFound(err) {
+		return false, fmt.Errorf("unable to delete the log archiver pod '%s', err: %v", name, err)
+	}
+	chaosTypes.Log.Info("Archived the logs of chaos pods", "chaosengine", engine.Instance.Name, "location", location)
+	return false, nil
+}
+
+// removeStaleLogArchivers removes the finished archiver pods of the previous runs of the chaosengine, which
+// are left behind if the chaosengine has been restarted before the logs of the previous run are copied
+func (r *ReconcileChaosEngine) removeStaleLogArchivers(engine *chaosTypes.EngineInfo) error {
+	var podList corev1.PodList
+	opts := []client.ListOption{
+		client.InNamespace(engine.Instance.Namespace),
+		client.MatchingLabels{logArchiveUIDLabelKey: string(engine.Instance.UID)},
+	}
+	if err := r.client.List(context.TODO(), &podList, opts...); err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		archiverPod := &podList.Items[i]
+		if archiverPod.Name == getLogArchiveName(engine.Instance) {
+			continue
+		}
+		if archiverPod.Status.Phase != corev1.PodSucceeded && archiverPod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if err := r.client.Delete(context.TODO(), archiverPod); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete the log archiver pod '%s', err: %v", archiverPod.Name, err)
+		}
+	}
+	return nil
+}
+
+// truncateChaosLogs keeps the tail of the logs within the maximum size. The size is shared among the logs,
+// so that the shorter logs are kept as it is and the longer ones are truncated to their share
+func truncateChaosLogs(logs map[string]string, maxSize int) map[string]string {
+	keys := make([]string, 0, len(logs))
+	for key := range logs {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return len(logs[keys[i]]) < len(logs[keys[j]])
+	})
+
+	truncated := make(map[string]string, len(logs))
+	remaining := maxSize
+	for i, key := range keys {
+		share := remaining / (len(keys) - i)
+		log := logs[key]
+		if len(log) > share {
+			tail := share - len(logArchiveTruncationNote)
+			if tail < 0 {
+				tail = 0
+			}
+			log = log[len(log)-tail:]
+			// the tail is trimmed to the start of a rune, so that the truncated logs are valid utf-8
+			for len(log) > 0 && !utf8.RuneStart(log[0]) {
+				log = log[1:]
+			}
+			if tail > 0 {
+				log = logArchiveTruncationNote + log
+			}
+		}
+		truncated[key] = log
+		remaining -= len(log)
+	}
+	return truncated
+}
+
+// validateLogArchive validates the type of the log archive, and the pvc for the pvc type
+func validateLogArchive(logArchive *litmuschaosv1alpha1.LogArchive) error {
+	switch logArchive.Type {
+	case litmuschaosv1alpha1.LogArchiveTypeConfigMap:
+		return nil
+	case litmuschaosv1alpha1.LogArchiveTypePVC:
+		if logArchive.ClaimName == "" {
+			return fmt.Errorf("claimName is required for the pvc log archive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("log archive type '%s', is not supported it should be configmap or pvc", logArchive.Type)
+	}
+}
+
+// getLogArchiveTailLines returns the number of lines archived from each container
+func getLogArchiveTailLines(logArchive *litmuschaosv1alpha1.LogArchive) int64 {
+	if logArchive.TailLines != nil {
+		return *logArchive.TailLines
+	}
+	return defaultLogArchiveTailLines
+}
+
+// getChaosPodLogs returns the tail of the logs of all the containers of the chaos pods, keyed by pod and container
+// The logs which can't be fetched are replaced with the error, so that the remaining logs are still archived
+func (r *ReconcileChaosEngine) getChaosPodLogs(podList *corev1.PodList, tailLines int64) map[string]string {
+	logs := map[string]string{}
+	for _, chaosPod := range podList.Items {
+		for _, c := range chaosPod.Spec.Containers {
+			key := chaosPod.Name + "." + c.Name + ".log"
+			opts := &corev1.PodLogOptions{Container: c.Name, TailLines: &tailLines}
+			raw, err := r.clientSet.CoreV1().Pods(chaosPod.Namespace).GetLogs(chaosPod.Name, opts).Do().Raw()
+			if err != nil {
+				logs[key] = fmt.Sprintf("unable to get the logs, err: %v", err)
+				continue
+			}
+			logs[key] = string(raw)
+		}
+	}
+	return logs
+}
+
+// getLogArchiveName returns the name of the log archive of the current execution of the chaosengine
+func getLogArchiveName(cr *litmuschaosv1alpha1.ChaosEngine) string {
+	if cr.Status.RunID == "" {
+		return cr.Name + "-logs"
+	}
+	return cr.Name + "-logs-" + cr.Status.RunID
+}
+
+// getLogArchiveDir returns the directory of the logs of the current execution inside the pvc
+func getLogArchiveDir(cr *litmuschaosv1alpha1.ChaosEngine) string {
+	if cr.Status.RunID == "" {
+		return cr.Name
+	}
+	return path.Join(cr.Name, cr.Status.RunID)
+}
+
+// getLogArchiveLabels returns the labels of the log archive configmap, which is removed
+// by the chaosUID label along with the chaosresults of the chaosengine
+func getLogArchiveLabels(cr *litmuschaosv1alpha1.ChaosEngine) map[string]string {
+	return withRunIDLabel(cr, map[string]string{
+		"chaosUID":                    string(cr.UID),
+		"app.kubernetes.io/component": logArchiveComponent,
+		"app.kubernetes.io/part-of":   "litmus",
+	})
+}
+
+// getLogArchiverPodLabels returns the labels of the archiver pod. It isn't labeled with the chaosUID, so that the
+// removal of the chaos pods on abort, restart or completion doesn't delete it in the middle of the copy
+func getLogArchiverPodLabels(cr *litmuschaosv1alpha1.ChaosEngine) map[string]string {
+	return withRunIDLabel(cr, map[string]string{
+		logArchiveUIDLabelKey:         string(cr.UID),
+		"app.kubernetes.io/component": logArchiveComponent,
+		"app.kubernetes.io/part-of":   "litmus",
+	})
+}
+
+// newLogArchiveConfigMapForCR defines the configmap, which stores the archived logs
+func newLogArchiveConfigMapForCR(engine *chaosTypes.EngineInfo, logs map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      getLogArchiveName(engine.Instance),
+			Namespace: engine.Instance.Namespace,
+			Labels:    getLogArchiveLabels(engine.Instance),
+		},
+		Data: logs,
+	}
+}
+
+// newLogArchiverPodForCR defines the pod, which copies the archived logs from the configmap into the pvc
+func newLogArchiverPodForCR(engine *chaosTypes.EngineInfo, configMapName string) (*corev1.Pod, error) {
+	logArchive := engine.Instance.Spec.LogArchive
+
+	image := logArchive.Image
+	if image == "" {
+		image = defaultLogArchiveImage
+	}
+
+	var volumeOpts utils.VolumeOpts
+	volumeOpts.VolumeOperations([]litmuschaosv1alpha1.ConfigMap{{Name: configMapName, MountPath: logArchiveMountPath}}, nil)
+	volumeOpts.VolumeSourceOperations(litmuschaosv1alpha1.VolumeSources{
+		PersistentVolumeClaims: []litmuschaosv1alpha1.PersistentVolumeClaim{{
+			Name:      "log-archive",
+			ClaimName: logArchive.ClaimName,
+			MountPath: logArchiveClaimMountPath,
+		}},
+	})
+
+	archiveDir := path.Join(logArchiveClaimMountPath, getLogArchiveDir(engine.Instance))
+	containerForArchiver := container.NewBuilder().
+		WithName("log-archiver").
+		WithImage(image).
+		WithImagePullPolicy(corev1.PullIfNotPresent).
+		WithCommandNew([]string{"/bin/sh", "-c"}).
+		WithArgumentsNew([]string{fmt.Sprintf("mkdir -p %s && cp -L %s/*.log %s/", archiveDir, logArchiveMountPath, archiveDir)}).
+		WithVolumeMountsNew(volumeOpts.VolumeMounts)
+
+	podObj, err := pod.NewBuilder().
+		WithName(getLogArchiveName(engine.Instance)).
+		WithNamespace(engine.Instance.Namespace).
+		WithLabels(getLogArchiverPodLabels(engine.Instance)).
+		WithServiceAccountName(engine.Instance.Spec.ChaosServiceAccount).
+		WithRestartPolicy(corev1.RestartPolicyNever).
+		WithVolumeBuilders(volumeOpts.VolumeBuilders).
+		WithContainerBuilder(containerForArchiver).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	podObj.Spec.Volumes = append(podObj.Spec.Volumes, volumeOpts.Volumes...)
+	return podObj, nil
+}
+
+// annotateChaosResultsWithLogArchive annotates the chaosresults of the chaosengine with the location of the archived logs
+func (r *ReconcileChaosEngine) annotateChaosResultsWithLogArchive(engine *chaosTypes.EngineInfo, location string) error {
+	chaosresultList, err := r.getChaosResultsForEngine(engine)
+	if err != nil {
+		return err
+	}
+	for i := range chaosresultList.Items {
+		result := &chaosresultList.Items[i]
+		previousLocation := result.Annotations[logArchiveAnnotationKey]
+		if previousLocation == location {
+			continue
+		}
+		patch := client.MergeFrom(result.DeepCopy())
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Annotations[logArchiveAnnotationKey] = location
+		if err := r.client.Patch(context.TODO(), result, patch); err != nil {
+			return fmt.Errorf("unable to annotate chaosresult '%s' with the log archive, err: %v", result.Name, err)
+		}
+		// the chaosresult only refers to the log archive of the latest run, so the archive of the previous run is removed
+		if err := r.removeLogArchive(result.Namespace, previousLocation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeLogArchive deletes the log archive configmap at the given location of the chaosresult annotation
+// The logs archived into the pvc are left untouched, as well as the configmaps not created as log archive
+func (r *ReconcileChaosEngine) removeLogArchive(namespace, location string) error {
+	if !strings.HasPrefix(location, "configmap/") {
+		return nil
+	}
+	name := strings.TrimPrefix(location, "configmap/")
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, configMap); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if configMap.Labels["app.kubernetes.io/component"] != logArchiveComponent {
+		return nil
+	}
+	if err := r.client.Delete(context.TODO(), configMap); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete the log archive configmap '%s', err: %v", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// runnerContainerName is the name of the runner container inside the runner pod
+const runnerContainerName = "chaos-runner"
+
+// applyRunnerPodTemplate applies the pod template of the runner as a strategic merge patch on the runner pod,
+// and validates that the protected fields of the runner pod are not overridden
+func applyRunnerPodTemplate(engine *chaosTypes.EngineInfo, runnerPod *corev1.Pod) (*corev1.Pod, error) {
+	podTemplate := engine.Instance.Spec.Components.Runner.PodTemplate
+	if podTemplate == nil || len(podTemplate.Raw) == 0 {
+		return runnerPod, nil
+	}
+
+	original, err := json.Marshal(runnerPod)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, podTemplate.Raw, corev1.Pod{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply the podTemplate of runner, err: %v", err)
+	}
+	patchedPod := &corev1.Pod{}
+	if err := json.Unmarshal(patched, patchedPod); err != nil {
+		return nil, fmt.Errorf("unable to apply the podTemplate of runner, err: %v", err)
+	}
+
+	if err := validateRunnerPodTemplate(runnerPod, patchedPod); err != nil {
+		return nil, err
+	}
+	return patchedPod, nil
+}
+
+// validateRunnerPodTemplate checks that the name, labels, owner references, service account and chaos ENVs
+// of the runner pod are not overridden by the pod template
+func validateRunnerPodTemplate(original, patched *corev1.Pod) error {
+	if patched.Name != original.Name || patched.Namespace != original.Namespace {
+		return fmt.Errorf("podTemplate of runner can't override the name or namespace of the runner pod")
+	}
+	// the runner pod is owned only by the chaosengine, which is set as its controller after the podTemplate is applied
+	if !reflect.DeepEqual(patched.OwnerReferences, original.OwnerReferences) {
+		return fmt.Errorf("podTemplate of runner can't set the ownerReferences of the runner pod")
+	}
+	for key, value := range original.Labels {
+		if patched.Labels[key] != value {
+			return fmt.Errorf("podTemplate of runner can't override the protected label '%s'", key)
+		}
+	}
+	if patched.Spec.ServiceAccountName != original.Spec.ServiceAccountName {
+		return fmt.Errorf("podTemplate of runner can't override the serviceAccountName")
+	}
+
+	originalContainer := getContainer(original, runnerContainerName)
+	patchedContainer := getContainer(patched, runnerContainerName)
+	if originalContainer == nil {
+		return nil
+	}
+	if patchedContainer == nil {
+		return fmt.Errorf("podTemplate of runner can't remove the '%s' container", runnerContainerName)
+	}
+	for _, env := range originalContainer.Env {
+		if !hasEnv(patchedContainer.Env, env) {
+			return fmt.Errorf("podTemplate of runner can't override the chaos ENV '%s'", env.Name)
+		}
+	}
+	return nil
+}
+
+// getContainer returns the container of the pod with the given name
+func getContainer(pod *corev1.Pod, name string) *corev1.Container {
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			return &pod.Spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+// hasEnv checks whether the ENV is present in the list with the same value or valueFrom, and is not duplicated
+func hasEnv(envs []corev1.EnvVar, env corev1.EnvVar) bool {
+	count := 0
+	for _, e := range envs {
+		if e.Name != env.Name {
+			continue
+		}
+		if !reflect.DeepEqual(e, env) {
+			return false
+		}
+		count++
+	}
+	return count == 1
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// rateLimitedReconciler requeues the failed reconciles of a ChaosEngine with the
+// delay derived from its own rate limiter, instead of the default rate limiter of the controller
+type rateLimitedReconciler struct {
+	reconciler  reconcile.Reconciler
+	rateLimiter workqueue.RateLimiter
+}
+
+var _ reconcile.Reconciler = &rateLimitedReconciler{}
+
+// newRateLimitedReconciler wraps the reconciler with an exponential failure rate limiter
+func newRateLimitedReconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return &rateLimitedReconciler{
+		reconciler:  r,
+		rateLimiter: workqueue.NewItemExponentialFailureRateLimiter(chaosTypes.RateLimiterBaseDelay, chaosTypes.RateLimiterMaxDelay),
+	}
+}
+
+// Reconcile reconciles the request, and requeues it after the backoff delay if the reconcile fails.
+// The error is intentionally hidden from the controller, as it would otherwise requeue the request with
+// its own rate limiter, so it is logged here instead. Hence the failed reconciles aren't reported in the
+// error metrics of the controller
+func (r *rateLimitedReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	result, err := r.reconciler.Reconcile(request)
+	if err != nil {
+		delay := r.rateLimiter.When(request)
+		chaosTypes.Log.Error(err, "Reconcile failed, requeueing the ChaosEngine", "Request.Namespace", request.Namespace, "Request.Name", request.Name, "after", delay.String(), "failures", r.rateLimiter.NumRequeues(request))
+		return reconcile.Result{RequeueAfter: delay}, nil
+	}
+	r.rateLimiter.Forget(request)
+	return result, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// verifyChaosRevert checks the chaos status of the targets of the aborted chaosengine. The engine is flagged with the
+// RevertIncomplete condition and a warning event, if the chaos is still injected on any target. It returns whether
+// the chaos has been reverted on all the targets
+func (r *ReconcileChaosEngine) verifyChaosRevert(engine *chaosTypes.EngineInfo) (bool, error) {
+	found, err := r.isResultCRDAvailable()
+	if err != nil || !found {
+		return true, err
+	}
+
+	chaosresultList, err := r.getChaosResultsForEngine(engine)
+	if err != nil {
+		return false, err
+	}
+	unrevertedTargets := getUnrevertedTargets(chaosresultList.Items)
+	if len(unrevertedTargets) == 0 {
+		removeEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineConditionRevertIncomplete)
+		return true, nil
+	}
+
+	message := "chaos is not reverted on the targets: " + strings.Join(unrevertedTargets, ", ")
+	changed := setEngineCondition(engine.Instance, litmuschaosv1alpha1.ChaosEngineCondition{
+		Type:    litmuschaosv1alpha1.ChaosEngineConditionRevertIncomplete,
+		Status:  corev1.ConditionTrue,
+		Reason:  "ChaosInjected",
+		Message: message,
+	})
+	// the event is generated only if the affected targets are changed, as the engine is requeued till the chaos is reverted
+	if changed {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosRevertIncomplete", "(chaos stop) %s", message)
+	}
+	return false, nil
+}
+
+// reconcileForRevert re-evaluates the chaos status of the targets of the stopped chaosengine, which is flagged with
+// the RevertIncomplete condition. The engine is requeued till the chaos is reverted on all the targets
+func (r *ReconcileChaosEngine) reconcileForRevert(engine *chaosTypes.EngineInfo, request reconcile.Request) (reconcile.Result, error) {
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+
+	if err := r.updateChaosStatus(engine, request); err != nil {
+		return reconcile.Result{}, err
+	}
+	reverted, err := r.verifyChaosRevert(engine)
+	if err != nil {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to verify the chaos revert: %v", err)
+		return reconcile.Result{}, err
+	}
+
+	if err := r.client.Patch(context.TODO(), engine.Instance, patch); err != nil && !k8serrors.IsNotFound(err) {
+		r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosResourcesOperationFailed", "(chaos stop) Unable to update chaosengine")
+		return reconcile.Result{}, fmt.Errorf("unable to update the conditions of chaosEngine Resource, due to error: %v", err)
+	}
+	if !reverted {
+		return reconcile.Result{RequeueAfter: chaosTypes.RevertCheckInterval}, nil
+	}
+	r.recorder.Eventf(engine.Instance, corev1.EventTypeNormal, "ChaosRevertCompleted", "(chaos stop) Chaos is reverted on all the targets")
+	return reconcile.Result{}, nil
+}
+
+// isRevertIncomplete checks whether the chaosengine is flagged with the RevertIncomplete condition
+func isRevertIncomplete(instance *litmuschaosv1alpha1.ChaosEngine) bool {
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type == litmuschaosv1alpha1.ChaosEngineConditionRevertIncomplete && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// getUnrevertedTargets returns the targets of the chaosresults, on which the chaos is still injected
+// The chaos status of the targets is derived from the status and annotations of the chaosresults
+func getUnrevertedTargets(results []litmuschaosv1alpha1.ChaosResult) []string {
+	var unrevertedTargets []string
+	for _, result := range results {
+		targets, _ := getChaosStatus(result)
+		for _, target := range targets {
+			if strings.EqualFold(target.ChaosStatus, "injected") {
+				unrevertedTargets = append(unrevertedTargets, target.Kind+"/"+target.Name)
+			}
+		}
+	}
+	sort.Strings(unrevertedTargets)
+	return unrevertedTargets
+}
+
+// setEngineCondition adds or updates the condition of the chaosengine with the same type, and returns whether
+// its status or message has been changed. The transition time is updated only if the status has been changed
+func setEngineCondition(instance *litmuschaosv1alpha1.ChaosEngine, condition litmuschaosv1alpha1.ChaosEngineCondition) bool {
+	condition.LastTransitionTime = v1.Now()
+	for i := range instance.Status.Conditions {
+		existing := instance.Status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		instance.Status.Conditions[i] = condition
+		return existing.Status != condition.Status || existing.Message != condition.Message
+	}
+	instance.Status.Conditions = append(instance.Status.Conditions, condition)
+	return true
+}
+
+// removeEngineCondition removes the condition of the given type from the chaosengine
+func removeEngineCondition(instance *litmuschaosv1alpha1.ChaosEngine, conditionType litmuschaosv1alpha1.ChaosEngineConditionType) {
+	var conditions []litmuschaosv1alpha1.ChaosEngineCondition
+	for _, condition := range instance.Status.Conditions {
+		if condition.Type != conditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+	instance.Status.Conditions = conditions
+}
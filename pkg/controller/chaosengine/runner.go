@@ -0,0 +1,159 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// runnerBackend launches the runner of a chaosengine and tracks its completion
+type runnerBackend interface {
+	// launch creates the runner of the chaosengine, if it doesn't exist already
+	launch(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo, reqLogger logr.Logger) error
+	// isCompleted checks whether the runner of the chaosengine has completed
+	isCompleted(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo) bool
+}
+
+// getRunnerBackend returns the runner backend, selected by the runner type of the chaosengine
+func getRunnerBackend(engine *chaosTypes.EngineInfo) (runnerBackend, error) {
+	switch engine.Instance.Spec.Components.Runner.Type {
+	case "", litmuschaosv1alpha1.RunnerTypeGo:
+		return &podRunner{}, nil
+	case litmuschaosv1alpha1.RunnerTypeJob:
+		return &jobRunner{}, nil
+	case litmuschaosv1alpha1.RunnerTypeSimulated:
+		return &simulatedRunner{}, nil
+	case litmuschaosv1alpha1.RunnerTypeRunnerless:
+		return &runnerlessRunner{}, nil
+	default:
+		return nil, fmt.Errorf("runner type '%s', is not supported it should be go, job, simulated or runnerless", engine.Instance.Spec.Components.Runner.Type)
+	}
+}
+
+// podRunner launches the go based runner as a pod, owned by the chaosengine
+type podRunner struct{}
+
+func (*podRunner) launch(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo, reqLogger logr.Logger) error {
+	return r.checkEngineRunnerPod(engine, reqLogger)
+}
+
+func (*podRunner) isCompleted(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo) bool {
+	return r.checkRunnerContainerCompletedStatus(engine)
+}
+
+// jobRunner launches the go based runner as a job, owned by the chaosengine,
+// which retries the runner as per the backoffLimit and activeDeadlineSeconds of the runner
+type jobRunner struct{}
+
+func (*jobRunner) launch(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo, reqLogger logr.Logger) error {
+	if len(engine.AppExperiments) == 0 {
+		return errors.New("application experiment list is empty")
+	}
+	runnerJob, err := newRunnerJobForCR(engine)
+	if err != nil {
+		return err
+	}
+
+	// Set the chaosengine as the controller owner of runner job, so that
+	// it gets garbage collected along with the chaosengine
+	if err := controllerutil.SetControllerReference(engine.Instance, runnerJob, r.scheme); err != nil {
+		return err
+	}
+
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: runnerJob.Name, Namespace: runnerJob.Namespace}, &batchv1.Job{})
+	if err != nil && k8serrors.IsNotFound(err) {
+		reqLogger.Info("Creating a new engineRunner Job", "Job.Namespace", runnerJob.Namespace, "Job.Name", runnerJob.Name)
+		return r.client.Create(context.TODO(), runnerJob)
+	} else if err != nil {
+		return err
+	}
+	reqLogger.Info("Skip reconcile: engineRunner Job already exists", "Job.Namespace", runnerJob.Namespace, "Job.Name", runnerJob.Name)
+	return nil
+}
+
+func (*jobRunner) isCompleted(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo) bool {
+	runnerJob := batchv1.Job{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: getRunnerName(engine.Instance), Namespace: engine.Instance.Namespace}, &runnerJob); err != nil {
+		return false
+	}
+
+	if runnerJob.Status.Succeeded > 0 {
+		return true
+	}
+	// the failed runner job is not retried anymore, so the chaosengine is completed
+	for _, condition := range runnerJob.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			r.recorder.Eventf(engine.Instance, corev1.EventTypeWarning, "ChaosRunnerFailed", "Runner job %s failed, reason: %s", runnerJob.Name, condition.Reason)
+			return true
+		}
+	}
+	return false
+}
+
+// newRunnerJobForCR defines a new job, which runs the go based runner pod
+func newRunnerJobForCR(engine *chaosTypes.EngineInfo) (*batchv1.Job, error) {
+	runnerPod, err := newGoRunnerPodForCR(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        runnerPod.Name,
+			Namespace:   runnerPod.Namespace,
+			Labels:      runnerPod.Labels,
+			Annotations: runnerPod.Annotations,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          engine.Instance.Spec.Components.Runner.BackoffLimit,
+			ActiveDeadlineSeconds: engine.Instance.Spec.Components.Runner.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: v1.ObjectMeta{
+					Labels:      runnerPod.Labels,
+					Annotations: runnerPod.Annotations,
+				},
+				Spec: runnerPod.Spec,
+			},
+		},
+	}, nil
+}
+
+// simulatedRunner doesn't launch any runner, and completes the chaosengine immediately
+// It is used to test the lifecycle of the chaosengine, without injecting any chaos
+type simulatedRunner struct{}
+
+func (*simulatedRunner) launch(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo, reqLogger logr.Logger) error {
+	reqLogger.Info("Skip launching the runner for the simulated runner type", "chaosengine", engine.Instance.Name)
+	return nil
+}
+
+func (*simulatedRunner) isCompleted(r *ReconcileChaosEngine, engine *chaosTypes.EngineInfo) bool {
+	return true
+}
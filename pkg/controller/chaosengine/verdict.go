@@ -0,0 +1,117 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaosengine
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+)
+
+// getChaosResultsForEngine lists the chaosresults created for the given chaosengine
+func (r *ReconcileChaosEngine) getChaosResultsForEngine(engine *chaosTypes.EngineInfo) (*litmuschaosv1alpha1.ChaosResultList, error) {
+	chaosresultList := &litmuschaosv1alpha1.ChaosResultList{}
+	opts := []client.ListOption{
+		client.InNamespace(engine.Instance.Namespace),
+		client.MatchingLabels{"chaosUID": string(engine.Instance.UID)},
+	}
+	if err := r.client.List(context.TODO(), chaosresultList, opts...); err != nil {
+		return nil, err
+	}
+	return chaosresultList, nil
+}
+
+// updateEngineVerdict rolls up the verdicts of the chaosresults into the chaosengine status
+func (r *ReconcileChaosEngine) updateEngineVerdict(engine *chaosTypes.EngineInfo) error {
+	chaosresultList, err := r.getChaosResultsForEngine(engine)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(engine.Instance.DeepCopy())
+	if !aggregateResultVerdicts(engine.Instance, chaosresultList.Items) {
+		return nil
+	}
+	if err := r.client.Patch(context.TODO(), engine.Instance, patch); err != nil {
+		return fmt.Errorf("unable to patch verdict of chaosEngine Resource, due to error: %v", err)
+	}
+	return nil
+}
+
+// aggregateResultVerdicts merges the verdict, probe success percentage and fail step of the chaosresults
+// into the matching experiment statuses and derives the overall engine verdict.
+// It returns true if the status of the engine has been changed
+func aggregateResultVerdicts(instance *litmuschaosv1alpha1.ChaosEngine, results []litmuschaosv1alpha1.ChaosResult) bool {
+	oldStatus := instance.Status.DeepCopy()
+
+	for _, result := range results {
+		for i := range instance.Status.Experiments {
+			if instance.Status.Experiments[i].Name != result.Spec.ExperimentName {
+				continue
+			}
+			// the failed verdict of an experiment, whose job has failed, isn't overridden by the chaosresult
+			if result.Status.ExperimentStatus.Verdict != "" && instance.Status.Experiments[i].Status != litmuschaosv1alpha1.ExperimentStatusFailed {
+				instance.Status.Experiments[i].Verdict = string(result.Status.ExperimentStatus.Verdict)
+			}
+			instance.Status.Experiments[i].ProbeSuccessPercentage = result.Status.ExperimentStatus.ProbeSuccessPercentage
+			instance.Status.Experiments[i].FailStep = result.Status.ExperimentStatus.FailStep
+		}
+	}
+	instance.Status.Verdict = getEngineVerdict(instance)
+
+	return !reflect.DeepEqual(oldStatus, &instance.Status)
+}
+
+// getEngineVerdict derives the verdict of the engine from the verdicts of its experiments.
+// A failed experiment fails the engine, a stopped one stops it and
+// the verdict stays awaited till every experiment listed in the engine has a final verdict
+func getEngineVerdict(instance *litmuschaosv1alpha1.ChaosEngine) litmuschaosv1alpha1.ResultVerdict {
+	if len(instance.Spec.Experiments) == 0 {
+		return ""
+	}
+
+	verdict := litmuschaosv1alpha1.ResultVerdictPassed
+	for _, exp := range instance.Spec.Experiments {
+		switch litmuschaosv1alpha1.ResultVerdict(getExperimentVerdict(instance, exp.Name)) {
+		case litmuschaosv1alpha1.ResultVerdictFailed:
+			return litmuschaosv1alpha1.ResultVerdictFailed
+		case litmuschaosv1alpha1.ResultVerdictStopped:
+			verdict = litmuschaosv1alpha1.ResultVerdictStopped
+		case litmuschaosv1alpha1.ResultVerdictPassed:
+		default:
+			if verdict == litmuschaosv1alpha1.ResultVerdictPassed {
+				verdict = litmuschaosv1alpha1.ResultVerdictAwaited
+			}
+		}
+	}
+	return verdict
+}
+
+// getExperimentVerdict returns the verdict of the given experiment from the engine status
+func getExperimentVerdict(instance *litmuschaosv1alpha1.ChaosEngine, name string) string {
+	for _, exp := range instance.Status.Experiments {
+		if exp.Name == name {
+			return exp.Verdict
+		}
+	}
+	return ""
+}
@@ -58,9 +58,10 @@ func getRolloutList(clientSet dynamic.Interface, engine *chaosTypes.EngineInfo)
 // checkForChaosEnabledRollout  will check and count the total chaos enabled application
 func checkForChaosEnabledRollout(rolloutList *unstructured.UnstructuredList, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, int, error) {
 
+	annotationKey := GetAnnotationKey()
 	chaosEnabledRollout := 0
 	for _, rollout := range rolloutList.Items {
-		annotationValue := rollout.GetAnnotations()[ChaosAnnotationKey]
+		annotationValue := rollout.GetAnnotations()[annotationKey]
 		if IsChaosEnabled(annotationValue) {
 			chaosTypes.Log.Info("chaos candidate of", "kind:", engine.AppInfo.Kind, "appName: ", rollout.GetName(), "appUUID: ", rollout.GetUID())
 			chaosEnabledRollout++
@@ -17,19 +17,19 @@ limitations under the License.
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	appsV1 "k8s.io/api/apps/v1"
-	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
 )
 
 // CheckDaemonSetAnnotation will check the annotation of DaemonSet
-func CheckDaemonSetAnnotation(clientset kubernetes.Interface, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, error) {
-	targetAppList, err := getDaemonSetLists(clientset, engine)
+func CheckDaemonSetAnnotation(reader client.Reader, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, error) {
+	targetAppList, err := getDaemonSetLists(reader, engine)
 	if err != nil {
 		return engine, err
 	}
@@ -44,24 +44,27 @@ func CheckDaemonSetAnnotation(clientset kubernetes.Interface, engine *chaosTypes
 }
 
 // getDaemonSetLists will list the daemonSets which having the chaos label
-func getDaemonSetLists(clientset kubernetes.Interface, engine *chaosTypes.EngineInfo) (*appsV1.DaemonSetList, error) {
-	targetAppList, err := clientset.AppsV1().DaemonSets(engine.AppInfo.Namespace).List(metaV1.ListOptions{
-		LabelSelector: engine.Instance.Spec.Appinfo.Applabel,
-		FieldSelector: ""})
+func getDaemonSetLists(reader client.Reader, engine *chaosTypes.EngineInfo) (*appsV1.DaemonSetList, error) {
+	listOptions, err := getListOptions(engine)
 	if err != nil {
+		return nil, err
+	}
+	targetAppList := &appsV1.DaemonSetList{}
+	if err := reader.List(context.TODO(), targetAppList, listOptions...); err != nil {
 		return nil, fmt.Errorf("error while listing daemonSets with matching labels %s", engine.Instance.Spec.Appinfo.Applabel)
 	}
 	if len(targetAppList.Items) == 0 {
 		return nil, fmt.Errorf("no daemonSets apps with matching labels %s", engine.Instance.Spec.Appinfo.Applabel)
 	}
-	return targetAppList, err
+	return targetAppList, nil
 }
 
 // checkForChaosEnabledDaemonSet will check and count the total chaos enabled application
 func checkForChaosEnabledDaemonSet(targetAppList *appsV1.DaemonSetList, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, int, error) {
+	annotationKey := GetAnnotationKey()
 	chaosEnabledDaemonSet := 0
 	for _, daemonSet := range targetAppList.Items {
-		annotationValue := daemonSet.ObjectMeta.GetAnnotations()[ChaosAnnotationKey]
+		annotationValue := daemonSet.ObjectMeta.GetAnnotations()[annotationKey]
 		if IsChaosEnabled(annotationValue) {
 			chaosTypes.Log.Info("chaos candidate of", "kind:", engine.AppInfo.Kind, "appName: ", daemonSet.ObjectMeta.Name, "appUUID: ", daemonSet.ObjectMeta.UID)
 			chaosEnabledDaemonSet++
@@ -17,19 +17,19 @@ limitations under the License.
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	v1 "k8s.io/api/apps/v1"
-	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
 )
 
 // CheckDeploymentAnnotation will check the annotation of deployment
-func CheckDeploymentAnnotation(clientset kubernetes.Interface, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, error) {
-	targetAppList, err := getDeploymentLists(clientset, engine)
+func CheckDeploymentAnnotation(reader client.Reader, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, error) {
+	targetAppList, err := getDeploymentLists(reader, engine)
 	if err != nil {
 		return engine, err
 	}
@@ -44,24 +44,27 @@ func CheckDeploymentAnnotation(clientset kubernetes.Interface, engine *chaosType
 }
 
 // getDeploymentLists will list the deployments which having the chaos label
-func getDeploymentLists(clientset kubernetes.Interface, engine *chaosTypes.EngineInfo) (*v1.DeploymentList, error) {
-	targetAppList, err := clientset.AppsV1().Deployments(engine.AppInfo.Namespace).List(metaV1.ListOptions{
-		LabelSelector: engine.Instance.Spec.Appinfo.Applabel,
-		FieldSelector: ""})
+func getDeploymentLists(reader client.Reader, engine *chaosTypes.EngineInfo) (*v1.DeploymentList, error) {
+	listOptions, err := getListOptions(engine)
 	if err != nil {
+		return nil, err
+	}
+	targetAppList := &v1.DeploymentList{}
+	if err := reader.List(context.TODO(), targetAppList, listOptions...); err != nil {
 		return nil, fmt.Errorf("error while listing deployments with matching labels %s", engine.Instance.Spec.Appinfo.Applabel)
 	}
 	if len(targetAppList.Items) == 0 {
 		return nil, fmt.Errorf("no deployments apps with matching labels %s", engine.Instance.Spec.Appinfo.Applabel)
 	}
-	return targetAppList, err
+	return targetAppList, nil
 }
 
 // checkForChaosEnabledDeployment will check and count the total chaos enabled application
 func checkForChaosEnabledDeployment(targetAppList *v1.DeploymentList, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, int, error) {
+	annotationKey := GetAnnotationKey()
 	chaosEnabledDeployment := 0
 	for _, deployment := range targetAppList.Items {
-		annotationValue := deployment.ObjectMeta.GetAnnotations()[ChaosAnnotationKey]
+		annotationValue := deployment.ObjectMeta.GetAnnotations()[annotationKey]
 		if IsChaosEnabled(annotationValue) {
 			chaosTypes.Log.Info("chaos candidate of", "kind:", engine.AppInfo.Kind, "appName: ", deployment.ObjectMeta.Name, "appUUID: ", deployment.ObjectMeta.UID)
 			chaosEnabledDeployment++
@@ -57,9 +57,10 @@ func getDeploymentConfigList(clientSet dynamic.Interface, engine *chaosTypes.Eng
 // checkForChaosEnabledDeploymentConfig will check and count the total chaos enabled application
 func checkForChaosEnabledDeploymentConfig(deploymentConfigList *unstructured.UnstructuredList, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, int, error) {
 
+	annotationKey := GetAnnotationKey()
 	chaosEnabledDeploymentConfig := 0
 	for _, deploymentconfig := range deploymentConfigList.Items {
-		annotationValue := deploymentconfig.GetAnnotations()[ChaosAnnotationKey]
+		annotationValue := deploymentconfig.GetAnnotations()[annotationKey]
 		if IsChaosEnabled(annotationValue) {
 			chaosTypes.Log.Info("chaos candidate of", "kind:", engine.AppInfo.Kind, "appName: ", deploymentconfig.GetName(), "appUUID: ", deploymentconfig.GetUID())
 			chaosEnabledDeploymentConfig++
@@ -18,12 +18,13 @@ package resource
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
+	"github.com/litmuschaos/chaos-operator/pkg/config"
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Annotations on app to enable chaos on it
@@ -32,15 +33,11 @@ const (
 	DefaultChaosAnnotationKey = "litmuschaos.io/chaos"
 )
 
-var (
-	// ChaosAnnotationKey is global variable used as the Key for annotation check.
-	ChaosAnnotationKey = GetAnnotationKey()
-)
-
 // GetAnnotationKey returns the annotation to be used while validating applications.
+// It is derived from the operator configuration on each call, so that the updates are applied without restart
 func GetAnnotationKey() string {
 
-	annotationKey := os.Getenv("CUSTOM_ANNOTATION")
+	annotationKey := config.Get().AnnotationKey
 	if len(annotationKey) != 0 {
 		return annotationKey
 	}
@@ -49,21 +46,22 @@ func GetAnnotationKey() string {
 }
 
 // CheckChaosAnnotation will check for the annotation of required resources
-func CheckChaosAnnotation(engine *chaosTypes.EngineInfo, clientset kubernetes.Interface, dynamicClientSet dynamic.Interface) (*chaosTypes.EngineInfo, error) {
+// The native workloads are read through the given (cached) reader, while the dynamic client is used for the custom workloads
+func CheckChaosAnnotation(engine *chaosTypes.EngineInfo, reader client.Reader, dynamicClientSet dynamic.Interface) (*chaosTypes.EngineInfo, error) {
 
 	switch strings.ToLower(engine.AppInfo.Kind) {
 	case "deployment", "deployments":
-		engine, err := CheckDeploymentAnnotation(clientset, engine)
+		engine, err := CheckDeploymentAnnotation(reader, engine)
 		if err != nil {
 			return engine, fmt.Errorf("resource type 'deployment', err: %+v", err)
 		}
 	case "statefulset", "statefulsets":
-		engine, err := CheckStatefulSetAnnotation(clientset, engine)
+		engine, err := CheckStatefulSetAnnotation(reader, engine)
 		if err != nil {
 			return engine, fmt.Errorf("resource type 'statefulset', err: %+v", err)
 		}
 	case "daemonset", "daemonsets":
-		engine, err := CheckDaemonSetAnnotation(clientset, engine)
+		engine, err := CheckDaemonSetAnnotation(reader, engine)
 		if err != nil {
 			return engine, fmt.Errorf("resource type 'daemonset', err: %+v", err)
 		}
@@ -87,3 +85,15 @@ func CheckChaosAnnotation(engine *chaosTypes.EngineInfo, clientset kubernetes.In
 func IsChaosEnabled(annotationValue string) bool {
 	return annotationValue == ChaosAnnotationValue
 }
+
+// getListOptions returns the list options to filter the applications in the app namespace with the app label
+func getListOptions(engine *chaosTypes.EngineInfo) ([]client.ListOption, error) {
+	selector, err := labels.Parse(engine.Instance.Spec.Appinfo.Applabel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the app label %s, err: %v", engine.Instance.Spec.Appinfo.Applabel, err)
+	}
+	return []client.ListOption{
+		client.InNamespace(engine.AppInfo.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	}, nil
+}
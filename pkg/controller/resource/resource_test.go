@@ -17,6 +17,7 @@ limitations under the License.
 package resource
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -27,7 +28,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
-	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
@@ -288,7 +291,7 @@ func TestCheckChaosAnnotationDeployment(t *testing.T) {
 			f.SetFakeClient()
 			if mock.check == true {
 				for _, deploy := range mock.deployment {
-					_, err := f.k8sClient.AppsV1().Deployments(deploy.Namespace).Create(&deploy)
+					err := f.k8sClient.Create(context.TODO(), &deploy)
 					if err != nil {
 						fmt.Printf("deployment not created, err: %v", err)
 					}
@@ -548,7 +551,7 @@ func TestCheckChaosAnnotationStatefulSet(t *testing.T) {
 			f.SetFakeClient()
 			if mock.check == true {
 				for _, sts := range mock.statefulSet {
-					_, err := f.k8sClient.AppsV1().StatefulSets(sts.Namespace).Create(&sts)
+					err := f.k8sClient.Create(context.TODO(), &sts)
 					if err != nil {
 						fmt.Printf("statefulset not created, err: %v", err)
 					}
@@ -808,7 +811,7 @@ func TestCheckChaosAnnotationDaemonset(t *testing.T) {
 			f.SetFakeClient()
 			if mock.check == true {
 				for _, ds := range mock.daemonset {
-					_, err := f.k8sClient.AppsV1().DaemonSets(ds.Namespace).Create(&ds)
+					err := f.k8sClient.Create(context.TODO(), &ds)
 					if err != nil {
 						fmt.Printf("daemonset not created, err: %v", err)
 					}
@@ -1396,8 +1399,8 @@ func TestCheckChaosAnnotationRollouts(t *testing.T) {
 
 type fixture struct {
 	t *testing.T
-	// k8sClient is the fake client set for k8s native objects.
-	k8sClient *fake.Clientset
+	// k8sClient is the fake client for k8s native objects.
+	k8sClient client.Client
 	// litmusClient is the fake client set for litmus cr objects.
 	litmusClient *litmusFakeClientset.Clientset
 
@@ -1411,7 +1414,7 @@ type fixture struct {
 // SetFakeClient initilizes the fake required clientsets
 func (f *fixture) SetFakeClient() {
 	// Load kubernetes client set by preloading with k8s objects.
-	f.k8sClient = fake.NewSimpleClientset(f.k8sObjects...)
+	f.k8sClient = fake.NewFakeClientWithScheme(scheme.Scheme, f.k8sObjects...)
 
 	// Load litmus client set by preloading with litmus objects.
 	f.litmusClient = litmusFakeClientset.NewSimpleClientset(f.litmusObjects...)
@@ -17,19 +17,19 @@ limitations under the License.
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	appsV1 "k8s.io/api/apps/v1"
-	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
 )
 
 // CheckStatefulSetAnnotation will check the annotation of StatefulSet
-func CheckStatefulSetAnnotation(clientset kubernetes.Interface, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, error) {
-	targetAppList, err := getStatefulSetLists(clientset, engine)
+func CheckStatefulSetAnnotation(reader client.Reader, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, error) {
+	targetAppList, err := getStatefulSetLists(reader, engine)
 	if err != nil {
 		return engine, err
 	}
@@ -44,24 +44,27 @@ func CheckStatefulSetAnnotation(clientset kubernetes.Interface, engine *chaosTyp
 }
 
 // getStatefulSetLists will list the statefulset which having the chaos label
-func getStatefulSetLists(clientset kubernetes.Interface, engine *chaosTypes.EngineInfo) (*appsV1.StatefulSetList, error) {
-	targetAppList, err := clientset.AppsV1().StatefulSets(engine.AppInfo.Namespace).List(metaV1.ListOptions{
-		LabelSelector: engine.Instance.Spec.Appinfo.Applabel,
-		FieldSelector: ""})
+func getStatefulSetLists(reader client.Reader, engine *chaosTypes.EngineInfo) (*appsV1.StatefulSetList, error) {
+	listOptions, err := getListOptions(engine)
 	if err != nil {
+		return nil, err
+	}
+	targetAppList := &appsV1.StatefulSetList{}
+	if err := reader.List(context.TODO(), targetAppList, listOptions...); err != nil {
 		return nil, fmt.Errorf("error while listing statefulsets with matching labels %s", engine.Instance.Spec.Appinfo.Applabel)
 	}
 	if len(targetAppList.Items) == 0 {
 		return nil, fmt.Errorf("no statefulset apps with matching labels %s", engine.Instance.Spec.Appinfo.Applabel)
 	}
-	return targetAppList, err
+	return targetAppList, nil
 }
 
 // checkForChaosEnabledStatefulSet will check and count the total chaos enabled application
 func checkForChaosEnabledStatefulSet(targetAppList *appsV1.StatefulSetList, engine *chaosTypes.EngineInfo) (*chaosTypes.EngineInfo, int, error) {
+	annotationKey := GetAnnotationKey()
 	chaosEnabledStatefulSet := 0
 	for _, statefulSet := range targetAppList.Items {
-		annotationValue := statefulSet.ObjectMeta.GetAnnotations()[ChaosAnnotationKey]
+		annotationValue := statefulSet.ObjectMeta.GetAnnotations()[annotationKey]
 		if IsChaosEnabled(annotationValue) {
 			chaosTypes.Log.Info("chaos candidate of", "kind:", engine.AppInfo.Kind, "appName: ", statefulSet.ObjectMeta.Name, "appUUID: ", statefulSet.ObjectMeta.UID)
 			chaosEnabledStatefulSet++
@@ -32,6 +32,8 @@ limitations under the License.
 package types
 
 import (
+	"time"
+
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 
 	"github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
@@ -56,6 +58,36 @@ var (
 	DefaultChaosRunnerImage = "litmuschaos/chaos-runner:latest"
 
 	ResultCRDName = "chaosresults.litmuschaos.io"
+
+	// TerminationTimeout is the duration for which the aborted engine waits for the termination of chaos pods
+	TerminationTimeout = 180 * time.Second
+
+	// StoppingRequeueInterval is the interval at which the aborted engine is requeued, till the chaos pods are terminated
+	StoppingRequeueInterval = 5 * time.Second
+
+	// RevertCheckInterval is the interval at which the stopped engine is requeued, till the chaos is reverted on all the targets
+	RevertCheckInterval = 30 * time.Second
+
+	// VerdictRequeueInterval is the interval at which the completed engine is requeued, till its verdict is final
+	VerdictRequeueInterval = 5 * time.Second
+
+	// LogArchiveRequeueInterval is the interval at which the completed engine is requeued, till the logs are copied into the pvc
+	LogArchiveRequeueInterval = 5 * time.Second
+
+	// MaxConcurrentReconciles is the maximum number of ChaosEngines, which can be reconciled concurrently
+	MaxConcurrentReconciles = 1
+
+	// RateLimiterBaseDelay is the delay for the first requeue of a failed reconcile, which is doubled on each failure
+	RateLimiterBaseDelay = 5 * time.Millisecond
+
+	// RateLimiterMaxDelay is the maximum delay for the requeue of a failed reconcile
+	RateLimiterMaxDelay = 1000 * time.Second
+
+	// ResyncPeriod is the minimum interval at which all the watched resources are reconciled
+	ResyncPeriod = 10 * time.Hour
+
+	// WatchNamespaces are the namespaces cached by the manager, all the namespaces are cached if it is empty
+	WatchNamespaces []string
 )
 
 // ApplicationInfo contains the chaos details for target application
@@ -40,6 +40,8 @@ import (
 type VolumeOpts struct {
 	VolumeMounts   []corev1.VolumeMount
 	VolumeBuilders []*volume.Builder
+	// Volumes contains the volumes, which can't be built by the volume builders
+	Volumes []corev1.Volume
 }
 
 // ENVDetails contains the ENV details
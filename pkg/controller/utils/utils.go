@@ -41,3 +41,22 @@ func (envDetails *ENVDetails) SetEnv(key, value string) *ENVDetails {
 	}
 	return envDetails
 }
+
+// MergeEnv merges the override ENVs into the base ENVs, the override ENV replaces the base ENV with the same name
+func MergeEnv(base, overrides []corev1.EnvVar) []corev1.EnvVar {
+	merged := append([]corev1.EnvVar{}, base...)
+	for _, override := range overrides {
+		found := false
+		for i := range merged {
+			if merged[i].Name == override.Name {
+				merged[i] = override
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, override)
+		}
+	}
+	return merged
+}
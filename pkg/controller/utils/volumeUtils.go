@@ -9,6 +9,8 @@ import (
 var (
 	// hostpathTypeFile represents the hostpath type
 	hostpathTypeFile = corev1.HostPathFile
+	// defaultVolumeMode is the default mode of the configmap and secret volumes, same as the volume builders
+	defaultVolumeMode = int32(420)
 )
 
 // CreateVolumeBuilders build Volume needed in execution of experiments
@@ -42,6 +44,20 @@ func CreateVolumeMounts(configMaps []v1alpha1.ConfigMap, secrets []v1alpha1.Secr
 func (volumeOpts *VolumeOpts) VolumeOperations(configMaps []v1alpha1.ConfigMap, secrets []v1alpha1.Secret) {
 	volumeOpts.VolumeBuilders = CreateVolumeBuilders(configMaps, secrets)
 	volumeOpts.VolumeMounts = CreateVolumeMounts(configMaps, secrets)
+	volumeOpts.Volumes = append(BuildVolumesForConfigMaps(configMaps), BuildVolumesForSecrets(secrets)...)
+}
+
+// VolumeSourceOperations adds the emptyDir, pvc, hostPath and projected token volumes into the VolumeOpts
+func (volumeOpts *VolumeOpts) VolumeSourceOperations(sources v1alpha1.VolumeSources) {
+	volumeOpts.VolumeBuilders = append(volumeOpts.VolumeBuilders, BuildVolumeBuilderForEmptyDirs(sources.EmptyDirs)...)
+	volumeOpts.VolumeBuilders = append(volumeOpts.VolumeBuilders, BuildVolumeBuilderForPersistentVolumeClaims(sources.PersistentVolumeClaims)...)
+	volumeOpts.VolumeBuilders = append(volumeOpts.VolumeBuilders, BuildVolumeBuilderForHostFileVolumes(sources.HostFileVolumes)...)
+	volumeOpts.Volumes = append(volumeOpts.Volumes, BuildVolumesForProjectedServiceAccountTokens(sources.ProjectedServiceAccountTokens)...)
+
+	volumeOpts.VolumeMounts = append(volumeOpts.VolumeMounts, BuildVolumeMountsForEmptyDirs(sources.EmptyDirs)...)
+	volumeOpts.VolumeMounts = append(volumeOpts.VolumeMounts, BuildVolumeMountsForPersistentVolumeClaims(sources.PersistentVolumeClaims)...)
+	volumeOpts.VolumeMounts = append(volumeOpts.VolumeMounts, BuildVolumeMountsForHostFileVolumes(sources.HostFileVolumes)...)
+	volumeOpts.VolumeMounts = append(volumeOpts.VolumeMounts, BuildVolumeMountsForProjectedServiceAccountTokens(sources.ProjectedServiceAccountTokens)...)
 }
 
 // BuildVolumeMountsForConfigMaps builds VolumeMounts for ConfigMaps
@@ -51,6 +67,8 @@ func BuildVolumeMountsForConfigMaps(configMaps []v1alpha1.ConfigMap) []corev1.Vo
 		var volumeMount corev1.VolumeMount
 		volumeMount.Name = v.Name
 		volumeMount.MountPath = v.MountPath
+		volumeMount.SubPath = v.SubPath
+		volumeMount.ReadOnly = v.ReadOnly
 		volumeMountsList = append(volumeMountsList, volumeMount)
 	}
 	return volumeMountsList
@@ -63,6 +81,8 @@ func BuildVolumeMountsForSecrets(secrets []v1alpha1.Secret) []corev1.VolumeMount
 		var volumeMount corev1.VolumeMount
 		volumeMount.Name = v.Name
 		volumeMount.MountPath = v.MountPath
+		volumeMount.SubPath = v.SubPath
+		volumeMount.ReadOnly = v.ReadOnly
 		volumeMountsList = append(volumeMountsList, volumeMount)
 	}
 	return volumeMountsList
@@ -75,6 +95,9 @@ func BuildVolumeBuilderForConfigMaps(configMaps []v1alpha1.ConfigMap) []*volume.
 		return nil
 	}
 	for _, v := range configMaps {
+		if hasKeyProjection(v.Items, v.DefaultMode) {
+			continue
+		}
 		volumeBuilder := volume.NewBuilder().
 			WithConfigMap(v.Name)
 		volumeBuilderList = append(volumeBuilderList, volumeBuilder)
@@ -89,9 +112,198 @@ func BuildVolumeBuilderForSecrets(secrets []v1alpha1.Secret) []*volume.Builder {
 		return nil
 	}
 	for _, v := range secrets {
+		if hasKeyProjection(v.Items, v.DefaultMode) {
+			continue
+		}
 		volumeBuilder := volume.NewBuilder().
 			WithSecret(v.Name)
 		volumeBuilderList = append(volumeBuilderList, volumeBuilder)
 	}
 	return volumeBuilderList
 }
+
+// BuildVolumeMountsForHostFileVolumes builds VolumeMounts for HostFileVolumes
+func BuildVolumeMountsForHostFileVolumes(hostFiles []v1alpha1.HostFile) []corev1.VolumeMount {
+	var volumeMountsList []corev1.VolumeMount
+	for _, v := range hostFiles {
+		var volumeMount corev1.VolumeMount
+		volumeMount.Name = v.Name
+		volumeMount.MountPath = v.MountPath
+		volumeMount.SubPath = v.SubPath
+		volumeMount.ReadOnly = v.ReadOnly
+		volumeMountsList = append(volumeMountsList, volumeMount)
+	}
+	return volumeMountsList
+}
+
+// BuildVolumeBuilderForHostFileVolumes builds VolumeBuilders for HostFileVolumes
+// The hostpath type defaults to File, if not provided
+func BuildVolumeBuilderForHostFileVolumes(hostFiles []v1alpha1.HostFile) []*volume.Builder {
+	volumeBuilderList := []*volume.Builder{}
+	if hostFiles == nil {
+		return nil
+	}
+	for _, v := range hostFiles {
+		hostpathType := hostpathTypeFile
+		if v.Type != "" {
+			hostpathType = v.Type
+		}
+		volumeBuilder := volume.NewBuilder().
+			WithName(v.Name).
+			WithHostPathAndType(v.NodePath, &hostpathType)
+		volumeBuilderList = append(volumeBuilderList, volumeBuilder)
+	}
+	return volumeBuilderList
+}
+
+// hasKeyProjection checks whether the items or default mode are provided for the configmap or secret volume,
+// which are not supported by the volume builders
+func hasKeyProjection(items []corev1.KeyToPath, defaultMode *int32) bool {
+	return items != nil || defaultMode != nil
+}
+
+// BuildVolumesForConfigMaps builds Volumes for the ConfigMaps with items or default mode
+func BuildVolumesForConfigMaps(configMaps []v1alpha1.ConfigMap) []corev1.Volume {
+	var volumeList []corev1.Volume
+	for _, v := range configMaps {
+		if !hasKeyProjection(v.Items, v.DefaultMode) {
+			continue
+		}
+		defaultMode := defaultVolumeMode
+		if v.DefaultMode != nil {
+			defaultMode = *v.DefaultMode
+		}
+		volumeList = append(volumeList, corev1.Volume{
+			Name: v.Name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: v.Name},
+					Items:                v.Items,
+					DefaultMode:          &defaultMode,
+				},
+			},
+		})
+	}
+	return volumeList
+}
+
+// BuildVolumesForSecrets builds Volumes for the Secrets with items or default mode
+func BuildVolumesForSecrets(secrets []v1alpha1.Secret) []corev1.Volume {
+	var volumeList []corev1.Volume
+	for _, v := range secrets {
+		if !hasKeyProjection(v.Items, v.DefaultMode) {
+			continue
+		}
+		defaultMode := defaultVolumeMode
+		if v.DefaultMode != nil {
+			defaultMode = *v.DefaultMode
+		}
+		volumeList = append(volumeList, corev1.Volume{
+			Name: v.Name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  v.Name,
+					Items:       v.Items,
+					DefaultMode: &defaultMode,
+				},
+			},
+		})
+	}
+	return volumeList
+}
+
+// BuildVolumeMountsForEmptyDirs builds VolumeMounts for EmptyDirs
+func BuildVolumeMountsForEmptyDirs(emptyDirs []v1alpha1.EmptyDir) []corev1.VolumeMount {
+	var volumeMountsList []corev1.VolumeMount
+	for _, v := range emptyDirs {
+		var volumeMount corev1.VolumeMount
+		volumeMount.Name = v.Name
+		volumeMount.MountPath = v.MountPath
+		volumeMount.SubPath = v.SubPath
+		volumeMountsList = append(volumeMountsList, volumeMount)
+	}
+	return volumeMountsList
+}
+
+// BuildVolumeBuilderForEmptyDirs builds VolumeBuilders for EmptyDirs
+func BuildVolumeBuilderForEmptyDirs(emptyDirs []v1alpha1.EmptyDir) []*volume.Builder {
+	volumeBuilderList := []*volume.Builder{}
+	if emptyDirs == nil {
+		return nil
+	}
+	for _, v := range emptyDirs {
+		volumeBuilder := volume.NewBuilder().
+			WithName(v.Name).
+			WithEmptyDir(&corev1.EmptyDirVolumeSource{
+				Medium:    v.Medium,
+				SizeLimit: v.SizeLimit,
+			})
+		volumeBuilderList = append(volumeBuilderList, volumeBuilder)
+	}
+	return volumeBuilderList
+}
+
+// BuildVolumeMountsForPersistentVolumeClaims builds VolumeMounts for PersistentVolumeClaims
+func BuildVolumeMountsForPersistentVolumeClaims(claims []v1alpha1.PersistentVolumeClaim) []corev1.VolumeMount {
+	var volumeMountsList []corev1.VolumeMount
+	for _, v := range claims {
+		var volumeMount corev1.VolumeMount
+		volumeMount.Name = v.Name
+		volumeMount.MountPath = v.MountPath
+		volumeMount.SubPath = v.SubPath
+		volumeMount.ReadOnly = v.ReadOnly
+		volumeMountsList = append(volumeMountsList, volumeMount)
+	}
+	return volumeMountsList
+}
+
+// BuildVolumeBuilderForPersistentVolumeClaims builds VolumeBuilders for PersistentVolumeClaims
+func BuildVolumeBuilderForPersistentVolumeClaims(claims []v1alpha1.PersistentVolumeClaim) []*volume.Builder {
+	volumeBuilderList := []*volume.Builder{}
+	if claims == nil {
+		return nil
+	}
+	for _, v := range claims {
+		volumeBuilder := volume.NewBuilder().
+			WithName(v.Name).
+			WithPVCSource(v.ClaimName)
+		volumeBuilderList = append(volumeBuilderList, volumeBuilder)
+	}
+	return volumeBuilderList
+}
+
+// BuildVolumeMountsForProjectedServiceAccountTokens builds VolumeMounts for ProjectedServiceAccountTokens
+func BuildVolumeMountsForProjectedServiceAccountTokens(tokens []v1alpha1.ProjectedServiceAccountToken) []corev1.VolumeMount {
+	var volumeMountsList []corev1.VolumeMount
+	for _, v := range tokens {
+		var volumeMount corev1.VolumeMount
+		volumeMount.Name = v.Name
+		volumeMount.MountPath = v.MountPath
+		volumeMount.ReadOnly = true
+		volumeMountsList = append(volumeMountsList, volumeMount)
+	}
+	return volumeMountsList
+}
+
+// BuildVolumesForProjectedServiceAccountTokens builds Volumes for ProjectedServiceAccountTokens
+func BuildVolumesForProjectedServiceAccountTokens(tokens []v1alpha1.ProjectedServiceAccountToken) []corev1.Volume {
+	var volumeList []corev1.Volume
+	for _, v := range tokens {
+		volumeList = append(volumeList, corev1.Volume{
+			Name: v.Name,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					DefaultMode: v.DefaultMode,
+					Sources: []corev1.VolumeProjection{{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          v.Audience,
+							ExpirationSeconds: v.ExpirationSeconds,
+							Path:              v.Path,
+						},
+					}},
+				},
+			},
+		})
+	}
+	return volumeList
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"reflect"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+)
+
+const (
+	// ComponentLabelKey is the label key, which identifies the litmus component of a resource
+	ComponentLabelKey = "app.kubernetes.io/component"
+	// ChaosRunnerComponent is the value of component label for the chaos runner pod
+	ChaosRunnerComponent = "chaos-runner"
+	// ChaosUIDLabelKey is the label key, which contains the uid of the owner ChaosEngine
+	ChaosUIDLabelKey = "chaosUID"
+	// ChaosRunIDLabelKey is the label key, which contains the run id of the ChaosEngine execution
+	ChaosRunIDLabelKey = "chaosRunID"
+)
+
+// isChaosResource checks whether the resource is labeled with the chaosUID
+func isChaosResource(meta metav1.Object) bool {
+	return meta.GetLabels()[ChaosUIDLabelKey] != ""
+}
+
+// isChaosRunner checks whether the resource is labeled as chaos runner of a ChaosEngine
+func isChaosRunner(meta metav1.Object) bool {
+	return isChaosResource(meta) && meta.GetLabels()[ComponentLabelKey] == ChaosRunnerComponent
+}
+
+// runnerPodPredicate filters the chaos runner pods, and drops the updates
+// which doesn't change the phase, container termination or deletion of the pod
+func runnerPodPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isChaosRunner(e.Meta)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isChaosRunner(e.Meta)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isChaosRunner(e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if !isChaosRunner(e.MetaNew) {
+				return false
+			}
+			oldPod, okOld := e.ObjectOld.(*corev1.Pod)
+			newPod, okNew := e.ObjectNew.(*corev1.Pod)
+			if !okOld || !okNew {
+				return true
+			}
+			return isRunnerPodChanged(oldPod, newPod)
+		},
+	}
+}
+
+// isRunnerPodChanged checks for the changes in the runner pod, which are relevant for the reconcile
+func isRunnerPodChanged(oldPod, newPod *corev1.Pod) bool {
+	if oldPod.Status.Phase != newPod.Status.Phase {
+		return true
+	}
+	if !reflect.DeepEqual(oldPod.DeletionTimestamp, newPod.DeletionTimestamp) {
+		return true
+	}
+	return !reflect.DeepEqual(getTerminatedStates(oldPod), getTerminatedStates(newPod))
+}
+
+// getTerminatedStates returns the terminated state of all the containers of the pod
+func getTerminatedStates(pod *corev1.Pod) map[string]*corev1.ContainerStateTerminated {
+	states := map[string]*corev1.ContainerStateTerminated{}
+	for _, container := range pod.Status.ContainerStatuses {
+		states[container.Name] = container.State.Terminated
+	}
+	return states
+}
+
+// experimentJobPredicate filters the chaos experiment jobs, and drops the updates
+// which doesn't change the status of the job
+func experimentJobPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isChaosResource(e.Meta)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isChaosResource(e.Meta)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isChaosResource(e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if !isChaosResource(e.MetaNew) {
+				return false
+			}
+			oldJob, okOld := e.ObjectOld.(*batchv1.Job)
+			newJob, okNew := e.ObjectNew.(*batchv1.Job)
+			if !okOld || !okNew {
+				return true
+			}
+			return !reflect.DeepEqual(oldJob.Status, newJob.Status)
+		},
+	}
+}
+
+// chaosResultPredicate filters the ChaosResults of the ChaosEngines, and drops the
+// updates which doesn't change the status or annotations(chaos status of targets) of the result
+func chaosResultPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isChaosResource(e.Meta)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isChaosResource(e.Meta)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isChaosResource(e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if !isChaosResource(e.MetaNew) {
+				return false
+			}
+			oldResult, okOld := e.ObjectOld.(*litmuschaosv1alpha1.ChaosResult)
+			newResult, okNew := e.ObjectNew.(*litmuschaosv1alpha1.ChaosResult)
+			if !okOld || !okNew {
+				return true
+			}
+			return !reflect.DeepEqual(oldResult.Status, newResult.Status) ||
+				!reflect.DeepEqual(oldResult.Annotations, newResult.Annotations)
+		},
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+)
+
+var (
+	runnerLabels = map[string]string{ChaosUIDLabelKey: "engine-uid", ComponentLabelKey: ChaosRunnerComponent}
+	chaosLabels  = map[string]string{ChaosUIDLabelKey: "engine-uid"}
+)
+
+func TestIsChaosRunner(t *testing.T) {
+	tests := map[string]struct {
+		labels   map[string]string
+		isRunner bool
+	}{
+		"Test Positive-1": {
+			labels:   runnerLabels,
+			isRunner: true,
+		},
+		"Test Negative-1": {
+			labels:   chaosLabels,
+			isRunner: false,
+		},
+		"Test Negative-2": {
+			labels:   map[string]string{ComponentLabelKey: ChaosRunnerComponent},
+			isRunner: false,
+		},
+		"Test Negative-3": {
+			labels:   nil,
+			isRunner: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "runner", Labels: mock.labels}}
+			if isRunner := isChaosRunner(pod); isRunner != mock.isRunner {
+				t.Fatalf("Test %q failed: expected %v, got: %v", name, mock.isRunner, isRunner)
+			}
+		})
+	}
+}
+
+func TestIsRunnerPodChanged(t *testing.T) {
+	deletionTime := metav1.Now()
+	terminatedStatuses := []corev1.ContainerStatus{{
+		Name:  "chaos-runner",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+	}}
+	tests := map[string]struct {
+		oldPod    corev1.Pod
+		newPod    corev1.Pod
+		isChanged bool
+	}{
+		"Test Positive-1": {
+			oldPod:    corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}},
+			newPod:    corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			isChanged: true,
+		},
+		"Test Positive-2": {
+			oldPod:    corev1.Pod{},
+			newPod:    corev1.Pod{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deletionTime}},
+			isChanged: true,
+		},
+		"Test Positive-3": {
+			oldPod:    corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			newPod:    corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: terminatedStatuses}},
+			isChanged: true,
+		},
+		"Test Negative-1": {
+			oldPod:    corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			newPod:    corev1.Pod{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "2"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			isChanged: false,
+		},
+		"Test Negative-2": {
+			oldPod:    corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "chaos-runner"}}}},
+			newPod:    corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "chaos-runner", RestartCount: 1}}}},
+			isChanged: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			if isChanged := isRunnerPodChanged(&mock.oldPod, &mock.newPod); isChanged != mock.isChanged {
+				t.Fatalf("Test %q failed: expected %v, got: %v", name, mock.isChanged, isChanged)
+			}
+		})
+	}
+}
+
+func TestRunnerPodPredicate(t *testing.T) {
+	tests := map[string]struct {
+		oldPod   *corev1.Pod
+		newPod   *corev1.Pod
+		isCreate bool
+		isUpdate bool
+	}{
+		"Test Positive-1": {
+			oldPod:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			newPod:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+			isCreate: true,
+			isUpdate: true,
+		},
+		"Test Negative-1": {
+			oldPod:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			newPod:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels, ResourceVersion: "2"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			isCreate: true,
+			isUpdate: false,
+		},
+		"Test Negative-2": {
+			oldPod:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			newPod:   &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+			isCreate: false,
+			isUpdate: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := runnerPodPredicate()
+			if isCreate := p.Create(event.CreateEvent{Meta: mock.newPod, Object: mock.newPod}); isCreate != mock.isCreate {
+				t.Fatalf("Test %q failed: expected create %v, got: %v", name, mock.isCreate, isCreate)
+			}
+			if isDelete := p.Delete(event.DeleteEvent{Meta: mock.newPod, Object: mock.newPod}); isDelete != mock.isCreate {
+				t.Fatalf("Test %q failed: expected delete %v, got: %v", name, mock.isCreate, isDelete)
+			}
+			isUpdate := p.Update(event.UpdateEvent{MetaOld: mock.oldPod, ObjectOld: mock.oldPod, MetaNew: mock.newPod, ObjectNew: mock.newPod})
+			if isUpdate != mock.isUpdate {
+				t.Fatalf("Test %q failed: expected update %v, got: %v", name, mock.isUpdate, isUpdate)
+			}
+		})
+	}
+}
+
+func TestExperimentJobPredicate(t *testing.T) {
+	tests := map[string]struct {
+		oldJob   *batchv1.Job
+		newJob   *batchv1.Job
+		isCreate bool
+		isUpdate bool
+	}{
+		"Test Positive-1": {
+			oldJob:   &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}},
+			newJob:   &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}, Status: batchv1.JobStatus{Succeeded: 1}},
+			isCreate: true,
+			isUpdate: true,
+		},
+		"Test Negative-1": {
+			oldJob:   &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}, Status: batchv1.JobStatus{Active: 1}},
+			newJob:   &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels, ResourceVersion: "2"}, Status: batchv1.JobStatus{Active: 1}},
+			isCreate: true,
+			isUpdate: false,
+		},
+		"Test Negative-2": {
+			oldJob:   &batchv1.Job{},
+			newJob:   &batchv1.Job{Status: batchv1.JobStatus{Succeeded: 1}},
+			isCreate: false,
+			isUpdate: false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := experimentJobPredicate()
+			if isCreate := p.Create(event.CreateEvent{Meta: mock.newJob, Object: mock.newJob}); isCreate != mock.isCreate {
+				t.Fatalf("Test %q failed: expected create %v, got: %v", name, mock.isCreate, isCreate)
+			}
+			isUpdate := p.Update(event.UpdateEvent{MetaOld: mock.oldJob, ObjectOld: mock.oldJob, MetaNew: mock.newJob, ObjectNew: mock.newJob})
+			if isUpdate != mock.isUpdate {
+				t.Fatalf("Test %q failed: expected update %v, got: %v", name, mock.isUpdate, isUpdate)
+			}
+		})
+	}
+}
+
+func TestChaosResultPredicate(t *testing.T) {
+	tests := map[string]struct {
+		oldResult *litmuschaosv1alpha1.ChaosResult
+		newResult *litmuschaosv1alpha1.ChaosResult
+		isCreate  bool
+		isUpdate  bool
+	}{
+		"Test Positive-1": {
+			oldResult: &litmuschaosv1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}},
+			newResult: &litmuschaosv1alpha1.ChaosResult{
+				ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels},
+				Status:     litmuschaosv1alpha1.ChaosResultStatus{ExperimentStatus: litmuschaosv1alpha1.TestStatus{Verdict: "Pass"}},
+			},
+			isCreate: true,
+			isUpdate: true,
+		},
+		"Test Positive-2": {
+			oldResult: &litmuschaosv1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}},
+			newResult: &litmuschaosv1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels, Annotations: map[string]string{"app-1": "injected"}}},
+			isCreate:  true,
+			isUpdate:  true,
+		},
+		"Test Negative-1": {
+			oldResult: &litmuschaosv1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels}},
+			newResult: &litmuschaosv1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{Labels: chaosLabels, ResourceVersion: "2"}},
+			isCreate:  true,
+			isUpdate:  false,
+		},
+		"Test Negative-2": {
+			oldResult: &litmuschaosv1alpha1.ChaosResult{},
+			newResult: &litmuschaosv1alpha1.ChaosResult{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"app-1": "injected"}}},
+			isCreate:  false,
+			isUpdate:  false,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := chaosResultPredicate()
+			if isCreate := p.Create(event.CreateEvent{Meta: mock.newResult, Object: mock.newResult}); isCreate != mock.isCreate {
+				t.Fatalf("Test %q failed: expected create %v, got: %v", name, mock.isCreate, isCreate)
+			}
+			isUpdate := p.Update(event.UpdateEvent{MetaOld: mock.oldResult, ObjectOld: mock.oldResult, MetaNew: mock.newResult, ObjectNew: mock.newResult})
+			if isUpdate != mock.isUpdate {
+				t.Fatalf("Test %q failed: expected update %v, got: %v", name, mock.isUpdate, isUpdate)
+			}
+		})
+	}
+}
+
+func TestPredicatesWithUnexpectedObject(t *testing.T) {
+	// the updates of the unexpected types aren't dropped, as their changes can't be compared
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Labels: runnerLabels}}
+	e := event.UpdateEvent{MetaOld: configMap, ObjectOld: configMap, MetaNew: configMap, ObjectNew: configMap}
+	for name, update := range map[string]func(event.UpdateEvent) bool{
+		"runner pod":     runnerPodPredicate().Update,
+		"experiment job": experimentJobPredicate().Update,
+		"chaos result":   chaosResultPredicate().Update,
+	} {
+		if !update(e) {
+			t.Fatalf("Test %q failed: expected the update of configmap to be allowed", name)
+		}
+	}
+}
@@ -19,9 +19,12 @@ package watcher
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -33,89 +36,158 @@ import (
 	chaosTypes "github.com/litmuschaos/chaos-operator/pkg/controller/types"
 )
 
-// WatchForRunnerPod creates watcher for Chaos Runner Pod
-func WatchForRunnerPod(client client.Client, c controller.Controller) error {
+// EngineUIDIndex is the name of the field index on the UID of ChaosEngine
+const EngineUIDIndex = "metadata.uid"
 
-	runnerPodHandler := handlerForRunnerPod(client)
+// WatchForRunnerPod creates watcher for Chaos Runner Pod, which is owned by the ChaosEngine
+// Only the runner pod of the current run of the ChaosEngine is tracked
+func WatchForRunnerPod(clientSet client.Client, c controller.Controller) error {
+	reqLogger := chaosTypes.Log.WithName("Chaos Runner Watch")
 
-	return c.Watch(&source.Kind{Type: &corev1.Pod{}}, &runnerPodHandler)
+	runnerPodHandler := handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+			requests, err := createHandlerRequestForRunner(a, clientSet)
+			if err != nil {
+				reqLogger.Error(err, "Unable to get the ChaosEngine Resource", "namespace", a.Meta.GetNamespace())
+				return nil
+			}
+			return requests
+		}),
+	}
+
+	return c.Watch(&source.Kind{Type: &corev1.Pod{}}, &runnerPodHandler, runnerPodPredicate())
+}
+
+// WatchForExperimentJob creates watcher for the Chaos Experiment Jobs
+func WatchForExperimentJob(client client.Client, c controller.Controller) error {
+
+	experimentJobHandler := handlerForChaosResource(client)
+
+	return c.Watch(&source.Kind{Type: &batchv1.Job{}}, &experimentJobHandler, experimentJobPredicate())
 }
 
-// handlerForRunnerPod creates a event Handler for Chaos Runner Pod
-func handlerForRunnerPod(clientSet client.Client) handler.EnqueueRequestsFromMapFunc {
+// WatchForChaosResult creates watcher for the ChaosResults
+func WatchForChaosResult(client client.Client, c controller.Controller) error {
+
+	chaosResultHandler := handlerForChaosResource(client)
+
+	return c.Watch(&source.Kind{Type: &litmuschaosv1alpha1.ChaosResult{}}, &chaosResultHandler, chaosResultPredicate())
+}
+
+// handlerForChaosResource creates a event Handler for the chaos resources, which
+// maps them back to their ChaosEngine with the help of owner reference or chaosUID label
+func handlerForChaosResource(clientSet client.Client) handler.EnqueueRequestsFromMapFunc {
 	reqLogger := chaosTypes.Log.WithName("Chaos Resources Watch")
-	var runnerPodRequest []reconcile.Request
-	var err error
 
-	handlerForRunner := handler.EnqueueRequestsFromMapFunc{
+	return handler.EnqueueRequestsFromMapFunc{
 		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
-			runnerNameCheck := strings.HasSuffix(a.Meta.GetName(), "-runner")
-			if runnerNameCheck {
-				runnerPodRequest, err = createHandlerRequestForEngine(a, clientSet)
-				if err != nil {
-					reqLogger.Error(err, "Unable to get the ChaosEngine Resources", "namespace", a.Meta.GetNamespace())
-					return nil
-				}
+			requests, err := createHandlerRequestForEngine(a, clientSet)
+			if err != nil {
+				reqLogger.Error(err, "Unable to get the ChaosEngine Resources", "namespace", a.Meta.GetNamespace())
+				return nil
 			}
-			return runnerPodRequest
+			return requests
 		}),
 	}
-	return handlerForRunner
 }
 
-// handlerRequestFromEngineList initilize a event Watcher filtering the chaosEngine from the list.
-func handlerRequestFromEngineList(listChaosEngine litmuschaosv1alpha1.ChaosEngineList, chaosUID string) []reconcile.Request {
-	for i := range listChaosEngine.Items {
-		uuid := string(listChaosEngine.Items[i].GetUID())
-		if chaosUID == uuid {
-			return []reconcile.Request{
-				{NamespacedName: types.NamespacedName{
-					Name:      listChaosEngine.Items[i].GetName(),
-					Namespace: listChaosEngine.Items[i].GetNamespace(),
-				}},
-			}
+// IndexEngineUID adds an index on the UID of ChaosEngine, so that the
+// chaos resources can be mapped to their engine without listing the namespace
+func IndexEngineUID(indexer client.FieldIndexer) error {
+	return indexer.IndexField(&litmuschaosv1alpha1.ChaosEngine{}, EngineUIDIndex, func(obj runtime.Object) []string {
+		engine, ok := obj.(*litmuschaosv1alpha1.ChaosEngine)
+		if !ok {
+			return nil
 		}
-	}
-	return []reconcile.Request{
-		{NamespacedName: types.NamespacedName{
-			Name:      "",
-			Namespace: "",
-		}},
-	}
-
+		return []string{string(engine.GetUID())}
+	})
 }
 
-func getPodchaosUIDLabel(podLabels map[string]string) string {
-	var chaosUID string
-	if _, ok := podLabels["chaosUID"]; ok {
-		chaosUID = podLabels["chaosUID"]
+// getEngineOwnerRef returns the ChaosEngine owner reference of the chaos resource, if any
+func getEngineOwnerRef(a handler.MapObject) *metav1.OwnerReference {
+	for _, ownerRef := range a.Meta.GetOwnerReferences() {
+		if ownerRef.Kind == "ChaosEngine" && ownerRef.APIVersion == litmuschaosv1alpha1.SchemeGroupVersion.String() {
+			return &ownerRef
+		}
 	}
-	return chaosUID
+	return nil
 }
 
-func createListOptionsInNamespace(namespace string) []client.ListOption {
-	listOptions := []client.ListOption{
-		client.InNamespace(namespace),
-	}
-	return listOptions
+func getPodchaosUIDLabel(podLabels map[string]string) string {
+	return podLabels[ChaosUIDLabelKey]
 }
 
-func getChaosEngineList(listOptions []client.ListOption, clientSet client.Client) (litmuschaosv1alpha1.ChaosEngineList, error) {
+// getChaosEngineListByUID lists the ChaosEngines with the given UID from the indexed cache
+func getChaosEngineListByUID(chaosUID string, clientSet client.Client) (litmuschaosv1alpha1.ChaosEngineList, error) {
 	var listChaosEngine litmuschaosv1alpha1.ChaosEngineList
 
-	err := clientSet.List(context.TODO(), &listChaosEngine, listOptions...)
+	err := clientSet.List(context.TODO(), &listChaosEngine, client.MatchingFields{EngineUIDIndex: chaosUID})
 	if err != nil {
 		return litmuschaosv1alpha1.ChaosEngineList{}, err
 	}
 	return listChaosEngine, nil
 }
 
+// createHandlerRequestForEngine maps the chaos resource to its ChaosEngine, using the
+// owner reference if present, otherwise the chaosUID label. It returns no request if
+// the resource doesn't belong to any ChaosEngine
 func createHandlerRequestForEngine(a handler.MapObject, clientSet client.Client) ([]reconcile.Request, error) {
+	if ownerRef := getEngineOwnerRef(a); ownerRef != nil {
+		return []reconcile.Request{
+			{NamespacedName: types.NamespacedName{
+				Name:      ownerRef.Name,
+				Namespace: a.Meta.GetNamespace(),
+			}},
+		}, nil
+	}
+
 	chaosUID := getPodchaosUIDLabel(a.Meta.GetLabels())
-	listChaosEngine, err := getChaosEngineList(createListOptionsInNamespace(a.Meta.GetNamespace()), clientSet)
+	if chaosUID == "" {
+		return nil, nil
+	}
+
+	listChaosEngine, err := getChaosEngineListByUID(chaosUID, clientSet)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to get the ChaosEngine Resources in namespace: %v", a.Meta.GetNamespace())
+		return nil, fmt.Errorf("Unable to get the ChaosEngine Resource with uid: %v", chaosUID)
+	}
+
+	var requests []reconcile.Request
+	for _, engine := range listChaosEngine.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      engine.GetName(),
+				Namespace: engine.GetNamespace(),
+			},
+		})
+	}
+	return requests, nil
+}
+
+// createHandlerRequestForRunner maps the runner pod to its controller ChaosEngine. It returns no request,
+// if the runner pod belongs to a previous run of the ChaosEngine, as identified by the chaosRunID label
+func createHandlerRequestForRunner(a handler.MapObject, clientSet client.Client) ([]reconcile.Request, error) {
+	ownerRef := getEngineOwnerRef(a)
+	if ownerRef == nil || ownerRef.Controller == nil || !*ownerRef.Controller {
+		return nil, nil
+	}
+	request := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      ownerRef.Name,
+			Namespace: a.Meta.GetNamespace(),
+		},
+	}
+
+	engine := &litmuschaosv1alpha1.ChaosEngine{}
+	if err := clientSet.Get(context.TODO(), request.NamespacedName, engine); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Unable to get the ChaosEngine Resource: %v, err: %v", ownerRef.Name, err)
+	}
+
+	// the chaosengines initialized before the run ids were introduced, don't have any run id
+	if engine.Status.RunID != "" && a.Meta.GetLabels()[ChaosRunIDLabelKey] != engine.Status.RunID {
+		return nil, nil
 	}
-	chaosEngineListRequest := handlerRequestFromEngineList(listChaosEngine, chaosUID)
-	return chaosEngineListRequest, nil
+	return []reconcile.Request{request}, nil
 }
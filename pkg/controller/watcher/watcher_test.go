@@ -0,0 +1,245 @@
+/*
+Copyright 2019 LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	litmuschaosv1alpha1 "github.com/litmuschaos/chaos-operator/pkg/apis/litmuschaos/v1alpha1"
+)
+
+// indexedClient serves the field indexes on top of the fake client, which ignores the field selectors
+type indexedClient struct {
+	client.Client
+	indexers map[string]client.IndexerFunc
+}
+
+// IndexField registers the indexer of the field
+func (c *indexedClient) IndexField(obj runtime.Object, field string, extractValue client.IndexerFunc) error {
+	c.indexers[field] = extractValue
+	return nil
+}
+
+// List lists the objects, and filters them with the indexers of the fields in the field selector
+func (c *indexedClient) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if listOpts.FieldSelector == nil {
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	var filtered []runtime.Object
+	for _, item := range items {
+		matched := true
+		for _, requirement := range listOpts.FieldSelector.Requirements() {
+			if !containsValue(c.indexers[requirement.Field](item), requirement.Value) {
+				matched = false
+			}
+		}
+		if matched {
+			filtered = append(filtered, item)
+		}
+	}
+	return meta.SetList(list, filtered)
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// newFakeClient returns the fake client with the ChaosEngine UID index
+func newFakeClient(t *testing.T, objs ...runtime.Object) client.Client {
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatalf("Unable to add the kubernetes types to scheme: %v", err)
+	}
+	if err := litmuschaosv1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("Unable to add the litmus types to scheme: %v", err)
+	}
+	c := &indexedClient{
+		Client:   fake.NewFakeClientWithScheme(s, objs...),
+		indexers: map[string]client.IndexerFunc{},
+	}
+	if err := IndexEngineUID(c); err != nil {
+		t.Fatalf("Unable to index the ChaosEngine UID: %v", err)
+	}
+	return c
+}
+
+func newEngineOwnerRef(name string, controller bool) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: litmuschaosv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "ChaosEngine",
+		Name:       name,
+		UID:        "engine-uid",
+		Controller: &controller,
+	}
+}
+
+func TestCreateHandlerRequestForEngine(t *testing.T) {
+	engines := []runtime.Object{
+		&litmuschaosv1alpha1.ChaosEngine{ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "litmus", UID: "engine-uid"}},
+		&litmuschaosv1alpha1.ChaosEngine{ObjectMeta: metav1.ObjectMeta{Name: "other-engine", Namespace: "litmus", UID: "other-uid"}},
+	}
+	tests := map[string]struct {
+		ownerRefs []metav1.OwnerReference
+		labels    map[string]string
+		requests  []reconcile.Request
+	}{
+		"Test Positive-1": {
+			ownerRefs: []metav1.OwnerReference{newEngineOwnerRef("engine", true)},
+			requests:  []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "engine", Namespace: "app"}}},
+		},
+		"Test Positive-2": {
+			labels:   map[string]string{ChaosUIDLabelKey: "engine-uid"},
+			requests: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "engine", Namespace: "litmus"}}},
+		},
+		"Test Positive-3": {
+			ownerRefs: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: "experiment", UID: "job-uid"}},
+			labels:    map[string]string{ChaosUIDLabelKey: "other-uid"},
+			requests:  []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "other-engine", Namespace: "litmus"}}},
+		},
+		"Test Negative-1": {
+			requests: nil,
+		},
+		"Test Negative-2": {
+			labels:   map[string]string{ChaosUIDLabelKey: "unknown-uid"},
+			requests: nil,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "chaos-pod",
+					Namespace:       "app",
+					Labels:          mock.labels,
+					OwnerReferences: mock.ownerRefs,
+				},
+			}
+			requests, err := createHandlerRequestForEngine(handler.MapObject{Meta: pod, Object: pod}, newFakeClient(t, engines...))
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if !reflect.DeepEqual(requests, mock.requests) {
+				t.Fatalf("Test %q failed: expected requests %v, got: %v", name, mock.requests, requests)
+			}
+		})
+	}
+}
+
+func TestCreateHandlerRequestForRunner(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "engine", Namespace: "litmus"}}
+	tests := map[string]struct {
+		ownerRefs []metav1.OwnerReference
+		runID     string
+		engineRun string
+		noEngine  bool
+		requests  []reconcile.Request
+	}{
+		"Test Positive-1": {
+			ownerRefs: []metav1.OwnerReference{newEngineOwnerRef("engine", true)},
+			runID:     "abc123",
+			engineRun: "abc123",
+			requests:  []reconcile.Request{request},
+		},
+		"Test Positive-2": {
+			ownerRefs: []metav1.OwnerReference{newEngineOwnerRef("engine", true)},
+			runID:     "",
+			engineRun: "",
+			requests:  []reconcile.Request{request},
+		},
+		"Test Negative-1": {
+			ownerRefs: []metav1.OwnerReference{newEngineOwnerRef("engine", true)},
+			runID:     "old123",
+			engineRun: "abc123",
+			requests:  nil,
+		},
+		"Test Negative-2": {
+			ownerRefs: []metav1.OwnerReference{newEngineOwnerRef("engine", false)},
+			runID:     "abc123",
+			engineRun: "abc123",
+			requests:  nil,
+		},
+		"Test Negative-3": {
+			runID:     "abc123",
+			engineRun: "abc123",
+			requests:  nil,
+		},
+		"Test Negative-4": {
+			ownerRefs: []metav1.OwnerReference{newEngineOwnerRef("engine", true)},
+			runID:     "abc123",
+			noEngine:  true,
+			requests:  nil,
+		},
+	}
+	for name, mock := range tests {
+		t.Run(name, func(t *testing.T) {
+			var objs []runtime.Object
+			if !mock.noEngine {
+				objs = append(objs, &litmuschaosv1alpha1.ChaosEngine{
+					ObjectMeta: metav1.ObjectMeta{Name: "engine", Namespace: "litmus", UID: "engine-uid"},
+					Status:     litmuschaosv1alpha1.ChaosEngineStatus{RunID: mock.engineRun},
+				})
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "engine-runner",
+					Namespace: "litmus",
+					Labels: map[string]string{
+						ChaosUIDLabelKey:   "engine-uid",
+						ComponentLabelKey:  ChaosRunnerComponent,
+						ChaosRunIDLabelKey: mock.runID,
+					},
+					OwnerReferences: mock.ownerRefs,
+				},
+			}
+			requests, err := createHandlerRequestForRunner(handler.MapObject{Meta: pod, Object: pod}, newFakeClient(t, objs...))
+			if err != nil {
+				t.Fatalf("Test %q failed: expected error to be nil, got: %v", name, err)
+			}
+			if !reflect.DeepEqual(requests, mock.requests) {
+				t.Fatalf("Test %q failed: expected requests %v, got: %v", name, mock.requests, requests)
+			}
+		})
+	}
+}